@@ -0,0 +1,212 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// EdgeBetweennessCentrality computes the betweenness centrality of each edge in the graph using
+// Brandes' algorithm. The betweenness of an edge is the sum of the fractions of all shortest paths
+// between any two vertices s and t that pass through that edge. It is the core primitive for the
+// Girvan-Newman community detection, which repeatedly removes the edge with the highest value.
+//
+// The returned map has the same layout as the adjacency map: The centrality of the edge (u, v) can
+// be looked up as centralities[u][v]. In an undirected graph, each pair of vertices is considered
+// once, and the centrality of an edge is stored in both directions. In a weighted graph, the
+// shortest paths are determined using the edge weights, otherwise each edge counts as one hop.
+//
+// The values are not normalized. In an undirected path graph A-B-C, both edges have a betweenness
+// of 2, because each of them lies on the shortest path of two vertex pairs.
+func EdgeBetweennessCentrality[K comparable, T any](g Graph[K, T]) (map[K]map[K]float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	centralities := make(map[K]map[K]float64, len(adjacencyMap))
+
+	for vertex, adjacencies := range adjacencyMap {
+		centralities[vertex] = make(map[K]float64, len(adjacencies))
+		for adjacency := range adjacencies {
+			centralities[vertex][adjacency] = 0
+		}
+	}
+
+	for source := range adjacencyMap {
+		stack, predecessors, sigma := brandesShortestPaths(adjacencyMap, source, g.Traits().IsWeighted)
+		delta := make(map[K]float64, len(stack))
+
+		// Process the vertices in order of non-increasing distance from the source, so that the
+		// dependencies of all successors are known when a vertex is processed.
+		for i := len(stack) - 1; i >= 0; i-- {
+			w := stack[i]
+			for _, v := range predecessors[w] {
+				c := sigma[v] / sigma[w] * (1 + delta[w])
+				centralities[v][w] += c
+				delta[v] += c
+			}
+		}
+	}
+
+	if !g.Traits().IsDirected {
+		// In an undirected graph, each shortest path has been found from both of its ends, and
+		// the edge centrality has been split across both directions of the edge.
+		for vertex, adjacencies := range centralities {
+			for adjacency := range adjacencies {
+				if keyLess(adjacency, vertex) {
+					continue
+				}
+				value := (centralities[vertex][adjacency] + centralities[adjacency][vertex]) / 2
+				centralities[vertex][adjacency] = value
+				centralities[adjacency][vertex] = value
+			}
+		}
+	}
+
+	return centralities, nil
+}
+
+// HarmonicCentrality computes the harmonic centrality of each vertex in the graph. The harmonic
+// centrality of a vertex v is the sum of the reciprocals of the shortest path distances from all
+// other vertices to v. Unreachable vertices contribute 0 to this sum, so in contrast to closeness
+// centrality, harmonic centrality is well-defined for disconnected graphs as well.
+//
+// In a weighted graph, the distances are the sums of the edge weights along the shortest paths,
+// and the edge weights must not be negative. Vertices with a distance of 0 don't contribute to the
+// centrality. In an unweighted graph, each edge counts as one hop.
+func HarmonicCentrality[K comparable, T any](g Graph[K, T]) (map[K]float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	centralities := make(map[K]float64, len(adjacencyMap))
+
+	for vertex := range adjacencyMap {
+		centralities[vertex] = 0
+	}
+
+	for source := range adjacencyMap {
+		distances := shortestDistances(adjacencyMap, source, g.Traits().IsWeighted)
+
+		for target, distance := range distances {
+			if target == source || distance == 0 {
+				continue
+			}
+			centralities[target] += 1 / distance
+		}
+	}
+
+	return centralities, nil
+}
+
+// KatzCentrality computes the Katz centrality of each vertex in the graph. The Katz centrality of
+// a vertex v is computed as alpha times the sum of the centralities of all vertices with an edge
+// to v, plus beta. Thus, it takes all walks ending in v into account, where longer walks are
+// attenuated by the factor alpha. In contrast to the eigenvector centrality, it also works for
+// directed and disconnected graphs, since each vertex gets at least the centrality beta.
+//
+// The centralities are computed iteratively, starting with beta for each vertex. The computation
+// stops after the given number of iterations or as soon as the centralities don't change anymore.
+// In a weighted graph, the centrality passed along an edge is multiplied with the edge weight.
+//
+// The computation only converges if alpha is less than the reciprocal of the largest eigenvalue of
+// the adjacency matrix. Otherwise, the centralities keep growing with each iteration. The returned
+// centralities are normalized, so that the Euclidean norm of all centralities is 1.
+func KatzCentrality[K comparable, T any](g Graph[K, T], alpha, beta float64, iterations int) (map[K]float64, error) {
+	if alpha <= 0 {
+		return nil, fmt.Errorf("alpha must be positive, got %v", alpha)
+	}
+
+	if iterations < 1 {
+		return nil, fmt.Errorf("the number of iterations must be positive, got %d", iterations)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	centralities := make(map[K]float64, len(predecessorMap))
+
+	for vertex := range predecessorMap {
+		centralities[vertex] = beta
+	}
+
+	for i := 0; i < iterations; i++ {
+		next := make(map[K]float64, len(centralities))
+		change := 0.0
+
+		for vertex, predecessors := range predecessorMap {
+			sum := 0.0
+
+			for predecessor, edge := range predecessors {
+				weight := 1.0
+				if g.Traits().IsWeighted {
+					weight = float64(edge.Properties.Weight)
+				}
+				sum += weight * centralities[predecessor]
+			}
+
+			next[vertex] = alpha*sum + beta
+			change += math.Abs(next[vertex] - centralities[vertex])
+		}
+
+		centralities = next
+
+		if change < 1e-12 {
+			break
+		}
+	}
+
+	norm := 0.0
+
+	for _, centrality := range centralities {
+		norm += centrality * centrality
+	}
+
+	norm = math.Sqrt(norm)
+
+	if norm == 0 || math.IsInf(norm, 0) || math.IsNaN(norm) {
+		return nil, errors.New("Katz centralities cannot be normalized, alpha may be too large")
+	}
+
+	for vertex := range centralities {
+		centralities[vertex] /= norm
+	}
+
+	return centralities, nil
+}
+
+// brandesShortestPaths solves the single-source shortest paths problem as required by Brandes'
+// algorithm. It returns the vertices reachable from the source in order of non-decreasing
+// distance, the predecessors of each vertex on its shortest paths, and the number of shortest
+// paths from the source to each vertex.
+//
+// The distances are computed by dijkstra. Afterwards, an edge (v, w) lies on a shortest path if
+// w has been visited after v and its distance equals the distance of v plus the edge length.
+func brandesShortestPaths[K comparable](adjacencyMap map[K]map[K]Edge[K], source K, weighted bool) ([]K, map[K][]K, map[K]float64) {
+	distance := edgeDistance[K](weighted)
+	paths := dijkstra(adjacencyMap, source, distance, nil)
+
+	position := make(map[K]int, len(paths.order))
+	for i, vertex := range paths.order {
+		position[vertex] = i
+	}
+
+	predecessors := make(map[K][]K)
+	sigma := map[K]float64{source: 1}
+
+	for _, v := range paths.order {
+		for w, edge := range adjacencyMap[v] {
+			if position[w] <= position[v] || paths.distances[v]+distance(edge) != paths.distances[w] {
+				continue
+			}
+			sigma[w] += sigma[v]
+			predecessors[w] = append(predecessors[w], v)
+		}
+	}
+
+	return paths.order, predecessors, sigma
+}
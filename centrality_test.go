@@ -0,0 +1,258 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEdgeBetweennessCentrality(t *testing.T) {
+	tests := map[string]struct {
+		isDirected           bool
+		isWeighted           bool
+		vertices             []int
+		edges                []Edge[int]
+		expectedCentralities map[int]map[int]float64
+	}{
+		"undirected path graph": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			expectedCentralities: map[int]map[int]float64{
+				1: {2: 3},
+				2: {1: 3, 3: 4},
+				3: {2: 4, 4: 3},
+				4: {3: 3},
+			},
+		},
+		"directed path graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedCentralities: map[int]map[int]float64{
+				1: {2: 2},
+				2: {3: 2},
+				3: {},
+			},
+		},
+		"undirected square with two shortest paths": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			expectedCentralities: map[int]map[int]float64{
+				1: {2: 2, 4: 2},
+				2: {1: 2, 3: 2},
+				3: {2: 2, 4: 2},
+				4: {3: 2, 1: 2},
+			},
+		},
+		"weighted triangle with a detour": {
+			isWeighted: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedCentralities: map[int]map[int]float64{
+				1: {2: 2, 3: 0},
+				2: {1: 2, 3: 2},
+				3: {1: 0, 2: 2},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+		if test.isWeighted {
+			options = append(options, Weighted())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		centralities, err := EdgeBetweennessCentrality(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		for source, targets := range test.expectedCentralities {
+			if len(centralities[source]) != len(targets) {
+				t.Errorf("%s: edge count expectancy for %v doesn't match: expected %v, got %v", name, source, len(targets), len(centralities[source]))
+			}
+			for target, expected := range targets {
+				if math.Abs(centralities[source][target]-expected) > 1e-9 {
+					t.Errorf("%s: centrality expectancy of (%v, %v) doesn't match: expected %v, got %v", name, source, target, expected, centralities[source][target])
+				}
+			}
+		}
+	}
+}
+
+func TestHarmonicCentrality(t *testing.T) {
+	tests := map[string]struct {
+		isDirected           bool
+		isWeighted           bool
+		vertices             []int
+		edges                []Edge[int]
+		expectedCentralities map[int]float64
+	}{
+		"undirected path graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedCentralities: map[int]float64{1: 1.5, 2: 2, 3: 1.5},
+		},
+		"disconnected graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedCentralities: map[int]float64{1: 1, 2: 1, 3: 0},
+		},
+		"directed weighted graph": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 8}},
+			},
+			expectedCentralities: map[int]float64{1: 0, 2: 0.5, 3: 0.75},
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+		if test.isWeighted {
+			options = append(options, Weighted())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		centralities, err := HarmonicCentrality(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		for vertex, expected := range test.expectedCentralities {
+			if math.Abs(centralities[vertex]-expected) > 1e-9 {
+				t.Errorf("%s: centrality expectancy of %v doesn't match: expected %v, got %v", name, vertex, expected, centralities[vertex])
+			}
+		}
+	}
+}
+
+func TestKatzCentrality(t *testing.T) {
+	tests := map[string]struct {
+		isDirected           bool
+		vertices             []int
+		edges                []Edge[int]
+		alpha                float64
+		expectedCentralities map[int]float64
+		shouldFail           bool
+	}{
+		"directed star graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 2, Target: 1},
+				{Source: 3, Target: 1},
+			},
+			alpha: 0.5,
+			// The raw centralities are 2 for vertex 1 and 1 for the others.
+			expectedCentralities: map[int]float64{
+				1: 2 / math.Sqrt(6),
+				2: 1 / math.Sqrt(6),
+				3: 1 / math.Sqrt(6),
+			},
+		},
+		"undirected triangle": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			alpha: 0.1,
+			expectedCentralities: map[int]float64{
+				1: 1 / math.Sqrt(3),
+				2: 1 / math.Sqrt(3),
+				3: 1 / math.Sqrt(3),
+			},
+		},
+		"non-positive alpha": {
+			vertices:   []int{1},
+			alpha:      0,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		centralities, err := KatzCentrality(graph, test.alpha, 1, 1000)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		for vertex, expected := range test.expectedCentralities {
+			if math.Abs(centralities[vertex]-expected) > 1e-9 {
+				t.Errorf("%s: centrality expectancy of %v doesn't match: expected %v, got %v", name, vertex, expected, centralities[vertex])
+			}
+		}
+	}
+}
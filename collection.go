@@ -1,94 +1,306 @@
 package graph
 
 import (
-	"errors"
+	"fmt"
+	"reflect"
 	"sort"
 )
 
-// priorityQueue is a priority queue implementation for minimum priorities, meaning that smaller
-// values will be prioritized. It maintains a descendingly ordered list of priority items.
+// PriorityQueue is a priority queue for minimum priorities, meaning that keys with a smaller
+// priority are popped first. It is implemented as a binary heap, so Push, Pop, and Update run in
+// O(log(n)) time. Each key is contained in the queue at most once, and its priority can be changed
+// using Update, which makes PriorityQueue suitable for algorithms like Dijkstra's.
 //
-// This is still a naive implementation, which is to be replaced with a binary heap implementation.
-type priorityQueue[T comparable] struct {
-	items []priorityItem[T]
+// The zero value is an empty queue ready to use.
+type PriorityQueue[K comparable] struct {
+	items   []priorityItem[K]
+	indices map[K]int
 }
 
-// priorityItem is an item in the priority queue, consisting of a priority and an actual value.
-type priorityItem[T comparable] struct {
-	value    T
-	priority float64
+// priorityItem is an item in the priority queue, consisting of a key and its priority.
+type priorityItem[K comparable] struct {
+	key      K
+	priority int
 }
 
-func newPriorityQueue[T comparable]() *priorityQueue[T] {
-	return &priorityQueue[T]{
-		items: make([]priorityItem[T], 0),
+// Push pushes the given key with the given priority into the queue. If the key already is in the
+// queue, its priority is set to the given priority, just like Update does.
+func (p *PriorityQueue[K]) Push(key K, priority int) {
+	if p.indices == nil {
+		p.indices = make(map[K]int)
+	}
+
+	if _, ok := p.indices[key]; ok {
+		p.Update(key, priority)
+		return
+	}
+
+	p.items = append(p.items, priorityItem[K]{key: key, priority: priority})
+	p.indices[key] = len(p.items) - 1
+	p.up(len(p.items) - 1)
+}
+
+// Pop removes the key with the smallest priority from the queue and returns it along with its
+// priority. If multiple keys have the smallest priority, an arbitrary one of them is returned. If
+// the queue is empty, Pop returns the zero value of K, which can be avoided by checking Len first.
+func (p *PriorityQueue[K]) Pop() (K, int) {
+	if len(p.items) == 0 {
+		var key K
+		return key, 0
+	}
+
+	item := p.items[0]
+	last := len(p.items) - 1
+
+	p.swap(0, last)
+	p.items = p.items[:last]
+	delete(p.indices, item.key)
+
+	if last > 0 {
+		p.down(0)
+	}
+
+	return item.key, item.priority
+}
+
+// Update changes the priority of the given key, which may both decrease and increase it. If the key
+// isn't in the queue, nothing happens.
+func (p *PriorityQueue[K]) Update(key K, priority int) {
+	index, ok := p.indices[key]
+	if !ok {
+		return
+	}
+
+	p.items[index].priority = priority
+
+	if !p.up(index) {
+		p.down(index)
 	}
 }
 
-// Push pushes a new item with the given priority into the queue. Because Push keeps track of the
-// descendant order of items and priorities, it has O(n) insertion time.
-func (p *priorityQueue[T]) Push(item T, priority float64) {
-	index := p.Len() - 1
+// Len returns the current length of the priority queue, i.e. the number of keys in the queue.
+func (p *PriorityQueue[K]) Len() int {
+	return len(p.items)
+}
 
-	for i := p.Len(); i > 0; i-- {
-		currentItem := p.items[i-1]
-		if currentItem.priority > priority {
-			index = i
+// up moves the item at the given index towards the root until the heap property is restored and
+// reports whether the item has been moved.
+func (p *PriorityQueue[K]) up(index int) bool {
+	moved := false
+
+	for index > 0 {
+		parent := (index - 1) / 2
+		if p.items[parent].priority <= p.items[index].priority {
 			break
 		}
+
+		p.swap(parent, index)
+		index = parent
+		moved = true
 	}
 
-	p.insertItemAt(item, priority, index)
+	return moved
 }
 
-// Pop returns the item with the smallest priority from the queue and removes that item. Returns an
-// error if the priority queue is empty, which can be tested using Len first.s
-func (p *priorityQueue[T]) Pop() (T, error) {
-	var priorityItem priorityItem[T]
+// down moves the item at the given index towards the leaves until the heap property is restored.
+func (p *PriorityQueue[K]) down(index int) {
+	for {
+		smallest := index
+		left, right := 2*index+1, 2*index+2
+
+		if left < len(p.items) && p.items[left].priority < p.items[smallest].priority {
+			smallest = left
+		}
+		if right < len(p.items) && p.items[right].priority < p.items[smallest].priority {
+			smallest = right
+		}
+
+		if smallest == index {
+			return
+		}
 
-	if p.Len() == 0 {
-		return priorityItem.value, errors.New("priority queue is empty")
+		p.swap(index, smallest)
+		index = smallest
 	}
+}
 
-	priorityItem = p.items[p.Len()-1]
-	p.items = p.items[:p.Len()-1]
+func (p *PriorityQueue[K]) swap(i, j int) {
+	p.items[i], p.items[j] = p.items[j], p.items[i]
+	p.indices[p.items[i].key] = i
+	p.indices[p.items[j].key] = j
+}
 
-	return priorityItem.value, nil
+// sortKeys sorts the given vertex hashes in ascending order. Hashes of a numeric or string type,
+// including named types such as `type ID int`, are sorted by their natural order. All other hashes
+// are sorted by their string representation.
+func sortKeys[K comparable](keys []K) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keyLess(keys[i], keys[j])
+	})
 }
 
-// DecreasePriority decreases the priority of a given item to the given priority. The item must be
-// pushed into the queue first. If the item doesn't exist, nothing happens.
-//
-// With the current implementation, DecreasePriority causes the items in the queue to be re-sorted.
-func (p *priorityQueue[T]) DecreasePriority(item T, priority float64) {
-	for i, currentItem := range p.items {
-		if currentItem.value == item {
-			p.items[i].priority = priority
+// sortedMapKeys returns the keys of the given map in ascending order.
+func sortedMapKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sortKeys(keys)
+
+	return keys
+}
+
+func keyLess[K comparable](a, b K) bool {
+	switch aValue := any(a).(type) {
+	case int:
+		return aValue < any(b).(int)
+	case int8:
+		return aValue < any(b).(int8)
+	case int16:
+		return aValue < any(b).(int16)
+	case int32:
+		return aValue < any(b).(int32)
+	case int64:
+		return aValue < any(b).(int64)
+	case uint:
+		return aValue < any(b).(uint)
+	case uint8:
+		return aValue < any(b).(uint8)
+	case uint16:
+		return aValue < any(b).(uint16)
+	case uint32:
+		return aValue < any(b).(uint32)
+	case uint64:
+		return aValue < any(b).(uint64)
+	case float32:
+		return aValue < any(b).(float32)
+	case float64:
+		return aValue < any(b).(float64)
+	case string:
+		return aValue < any(b).(string)
+	}
+
+	// Named types such as `type ID int` don't match any of the cases above. They are compared by
+	// the kind of their underlying type, so that they are sorted by their natural order as well.
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	if aValue.Kind() == bValue.Kind() {
+		switch aValue.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return aValue.Int() < bValue.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return aValue.Uint() < bValue.Uint()
+		case reflect.Float32, reflect.Float64:
+			return aValue.Float() < bValue.Float()
+		case reflect.String:
+			return aValue.String() < bValue.String()
+		}
+	}
+
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+// sortedEdges returns all edges from the given adjacency map in ascending order of their source
+// and target hashes. For an undirected graph, each edge is only returned once, namely with the
+// smaller hash as source.
+func sortedEdges[K comparable](adjacencyMap map[K]map[K]Edge[K], isDirected bool) []Edge[K] {
+	edges := make([]Edge[K], 0)
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			if !isDirected && keyLess(target, source) {
+				continue
+			}
+			edges = append(edges, edge)
 		}
 	}
 
-	sort.Slice(p.items, func(i, j int) bool {
-		return p.items[i].priority > p.items[j].priority
+	sort.SliceStable(edges, func(i, j int) bool {
+		if edges[i].Source != edges[j].Source {
+			return keyLess(edges[i].Source, edges[j].Source)
+		}
+		return keyLess(edges[i].Target, edges[j].Target)
 	})
+
+	return edges
 }
 
-// Len returns the current length of the priority queue, i.e. the number of items in the queue.
-func (p *priorityQueue[T]) Len() int {
-	return len(p.items)
+// insertionSequence records the order in which vertices and edges have been added to a graph by
+// assigning an increasing sequence number to each of them.
+type insertionSequence[K comparable] struct {
+	vertices map[K]int
+	edges    map[[2]K]int
+	next     int
 }
 
-func (p *priorityQueue[T]) insertItemAt(item T, priority float64, index int) {
-	priorityItem := priorityItem[T]{
-		value:    item,
-		priority: priority,
+func newInsertionSequence[K comparable]() *insertionSequence[K] {
+	return &insertionSequence[K]{
+		vertices: make(map[K]int),
+		edges:    make(map[[2]K]int),
 	}
+}
 
-	if p.Len() == 0 || p.Len() == index {
-		p.items = append(p.items, priorityItem)
+// addVertex records the given vertex unless it has been recorded before.
+func (s *insertionSequence[K]) addVertex(vertex K) {
+	if _, ok := s.vertices[vertex]; ok {
 		return
 	}
+	s.vertices[vertex] = s.next
+	s.next++
+}
+
+func (s *insertionSequence[K]) addEdge(source, target K) {
+	s.edges[[2]K{source, target}] = s.next
+	s.next++
+}
+
+func (s *insertionSequence[K]) removeEdge(source, target K) {
+	delete(s.edges, [2]K{source, target})
+}
 
-	p.items = append(p.items[:index+1], p.items[index:]...)
+func (s *insertionSequence[K]) clone() *insertionSequence[K] {
+	clone := &insertionSequence[K]{
+		vertices: make(map[K]int, len(s.vertices)),
+		edges:    make(map[[2]K]int, len(s.edges)),
+		next:     s.next,
+	}
+
+	for vertex, number := range s.vertices {
+		clone.vertices[vertex] = number
+	}
+
+	for edge, number := range s.edges {
+		clone.edges[edge] = number
+	}
+
+	return clone
+}
+
+// orderedVertices returns the recorded vertices in the order they have been added.
+func (s *insertionSequence[K]) orderedVertices() []K {
+	vertices := make([]K, 0, len(s.vertices))
+	for vertex := range s.vertices {
+		vertices = append(vertices, vertex)
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return s.vertices[vertices[i]] < s.vertices[vertices[j]]
+	})
+
+	return vertices
+}
+
+// orderedEdges returns the recorded edges in the order they have been added, each in the
+// orientation it has been added with.
+func (s *insertionSequence[K]) orderedEdges() [][2]K {
+	edges := make([][2]K, 0, len(s.edges))
+	for edge := range s.edges {
+		edges = append(edges, edge)
+	}
+
+	sort.Slice(edges, func(i, j int) bool {
+		return s.edges[edges[i]] < s.edges[edges[j]]
+	})
 
-	p.items[index] = priorityItem
+	return edges
 }
@@ -6,175 +6,194 @@ import (
 
 func TestPriorityQueue_Push(t *testing.T) {
 	tests := map[string]struct {
-		items                 []int
-		priorities            []float64
-		expectedPriorityItems []priorityItem[int]
+		keys          []int
+		priorities    []int
+		expectedOrder []int
 	}{
 		"queue with 5 elements": {
-			items:      []int{10, 20, 30, 40, 50},
-			priorities: []float64{6, 8, 2, 7, 5},
-			expectedPriorityItems: []priorityItem[int]{
-				{value: 20, priority: 8},
-				{value: 40, priority: 7},
-				{value: 10, priority: 6},
-				{value: 50, priority: 5},
-				{value: 30, priority: 2},
-			},
+			keys:          []int{10, 20, 30, 40, 50},
+			priorities:    []int{6, 8, 2, 7, 5},
+			expectedOrder: []int{30, 50, 10, 40, 20},
+		},
+		"larger priority pushed after smaller ones": {
+			keys:          []int{10, 20, 30},
+			priorities:    []int{3, 2, 5},
+			expectedOrder: []int{20, 10, 30},
+		},
+		"existing key pushed again": {
+			keys:          []int{10, 20, 30, 10},
+			priorities:    []int{1, 2, 3, 4},
+			expectedOrder: []int{20, 30, 10},
 		},
 	}
 
 	for name, test := range tests {
-		queue := newPriorityQueue[int]()
+		queue := &PriorityQueue[int]{}
 
-		for i, item := range test.items {
-			queue.Push(item, test.priorities[i])
+		for i, key := range test.keys {
+			queue.Push(key, test.priorities[i])
 		}
 
-		if len(queue.items) != len(test.expectedPriorityItems) {
-			t.Fatalf("%s: item length expectancy doesn't match: expected %v, got %v", name, len(test.expectedPriorityItems), len(queue.items))
+		order := make([]int, 0)
+		for queue.Len() > 0 {
+			key, _ := queue.Pop()
+			order = append(order, key)
 		}
 
-		for i, expectedPriorityItem := range test.expectedPriorityItems {
-			if queue.items[i] != expectedPriorityItem {
-				t.Errorf("%s: item doesn't match: expected %v at index %d, got %v", name, expectedPriorityItem, i, queue.items[i])
-			}
+		if !orderedSlicesAreEqual(order, test.expectedOrder) {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, test.expectedOrder, order)
 		}
 	}
 }
 
 func TestPriorityQueue_Pop(t *testing.T) {
 	tests := map[string]struct {
-		items        []int
-		priorities   []float64
-		expectedItem int
-		shouldFail   bool
+		keys             []int
+		priorities       []int
+		expectedKey      int
+		expectedPriority int
 	}{
-		"queue with 5 item": {
-			items:        []int{10, 20, 30, 40, 50},
-			priorities:   []float64{6, 8, 2, 7, 5},
-			expectedItem: 30,
-			shouldFail:   false,
+		"queue with 5 items": {
+			keys:             []int{10, 20, 30, 40, 50},
+			priorities:       []int{6, 8, 2, 7, 5},
+			expectedKey:      30,
+			expectedPriority: 2,
 		},
 		"queue with 1 item": {
-			items:        []int{10},
-			priorities:   []float64{6},
-			expectedItem: 10,
-			shouldFail:   false,
+			keys:             []int{10},
+			priorities:       []int{6},
+			expectedKey:      10,
+			expectedPriority: 6,
+		},
+		"queue with negative priorities": {
+			keys:             []int{10, 20},
+			priorities:       []int{-1, -3},
+			expectedKey:      20,
+			expectedPriority: -3,
 		},
 		"empty queue": {
-			items:      []int{},
-			priorities: []float64{},
-			shouldFail: true,
+			keys:             []int{},
+			priorities:       []int{},
+			expectedKey:      0,
+			expectedPriority: 0,
 		},
 	}
 
 	for name, test := range tests {
-		queue := newPriorityQueue[int]()
+		queue := &PriorityQueue[int]{}
 
-		for i, item := range test.items {
-			queue.Push(item, test.priorities[i])
+		for i, key := range test.keys {
+			queue.Push(key, test.priorities[i])
 		}
 
-		item, err := queue.Pop()
+		key, priority := queue.Pop()
 
-		if test.shouldFail != (err != nil) {
-			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, (err != nil), err)
+		if key != test.expectedKey {
+			t.Errorf("%s: key expectancy doesn't match: expected %v, got %v", name, test.expectedKey, key)
 		}
 
-		if item != test.expectedItem {
-			t.Errorf("%s: item expectancy doesn't match: expected %v, got %v", name, test.expectedItem, item)
+		if priority != test.expectedPriority {
+			t.Errorf("%s: priority expectancy doesn't match: expected %v, got %v", name, test.expectedPriority, priority)
 		}
 	}
 }
 
-func TestPriorityQueue_DecreasePriority(t *testing.T) {
+func TestPriorityQueue_Update(t *testing.T) {
 	tests := map[string]struct {
-		items                 []priorityItem[int]
-		expectedPriorityItems []priorityItem[int]
-		decreaseItem          int
-		decreasePriority      float64
+		keys           []int
+		priorities     []int
+		updateKey      int
+		updatePriority int
+		expectedOrder  []int
 	}{
 		"decrease 30 to priority 5": {
-			items: []priorityItem[int]{
-				{value: 40, priority: 40},
-				{value: 30, priority: 30},
-				{value: 20, priority: 20},
-				{value: 10, priority: 10},
-			},
-			decreaseItem:     30,
-			decreasePriority: 5,
-			expectedPriorityItems: []priorityItem[int]{
-				{value: 40, priority: 40},
-				{value: 20, priority: 20},
-				{value: 10, priority: 10},
-				{value: 30, priority: 5},
-			},
+			keys:           []int{40, 30, 20, 10},
+			priorities:     []int{40, 30, 20, 10},
+			updateKey:      30,
+			updatePriority: 5,
+			expectedOrder:  []int{30, 10, 20, 40},
 		},
-		"decrease a non-existent item": {
-			items: []priorityItem[int]{
-				{value: 40, priority: 40},
-				{value: 30, priority: 30},
-				{value: 20, priority: 20},
-				{value: 10, priority: 10},
-			},
-			decreaseItem:     50,
-			decreasePriority: 10,
-			expectedPriorityItems: []priorityItem[int]{
-				{value: 40, priority: 40},
-				{value: 30, priority: 30},
-				{value: 20, priority: 20},
-				{value: 10, priority: 10},
-			},
+		"increase 10 to priority 50": {
+			keys:           []int{40, 30, 20, 10},
+			priorities:     []int{40, 30, 20, 10},
+			updateKey:      10,
+			updatePriority: 50,
+			expectedOrder:  []int{20, 30, 40, 10},
+		},
+		"update a non-existent key": {
+			keys:           []int{40, 30, 20, 10},
+			priorities:     []int{40, 30, 20, 10},
+			updateKey:      50,
+			updatePriority: 1,
+			expectedOrder:  []int{10, 20, 30, 40},
 		},
 	}
 
 	for name, test := range tests {
-		queue := &priorityQueue[int]{
-			items: test.items,
+		queue := &PriorityQueue[int]{}
+
+		for i, key := range test.keys {
+			queue.Push(key, test.priorities[i])
 		}
 
-		queue.DecreasePriority(test.decreaseItem, test.decreasePriority)
+		queue.Update(test.updateKey, test.updatePriority)
 
-		if len(queue.items) != len(test.expectedPriorityItems) {
-			t.Fatalf("%s: item length expectancy doesn't match: expected %v, got %v", name, len(test.expectedPriorityItems), len(queue.items))
+		order := make([]int, 0)
+		for queue.Len() > 0 {
+			key, _ := queue.Pop()
+			order = append(order, key)
 		}
 
-		for i, expectedPriorityItem := range test.expectedPriorityItems {
-			if queue.items[i] != expectedPriorityItem {
-				t.Errorf("%s: item doesn't match: expected %v at index %d, got %v", name, expectedPriorityItem, i, queue.items[i])
-			}
+		if !orderedSlicesAreEqual(order, test.expectedOrder) {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, test.expectedOrder, order)
 		}
 	}
 }
 
 func TestPriorityQueue_Len(t *testing.T) {
 	tests := map[string]struct {
-		items       []int
-		priorities  []float64
+		keys        []int
+		priorities  []int
+		pops        int
 		expectedLen int
 	}{
-		"queue with 5 item": {
-			items:       []int{10, 20, 30, 40, 50},
-			priorities:  []float64{6, 8, 2, 7, 5},
+		"queue with 5 items": {
+			keys:        []int{10, 20, 30, 40, 50},
+			priorities:  []int{6, 8, 2, 7, 5},
 			expectedLen: 5,
 		},
 		"queue with 1 item": {
-			items:       []int{10},
-			priorities:  []float64{6},
+			keys:        []int{10},
+			priorities:  []int{6},
 			expectedLen: 1,
 		},
+		"queue with popped items": {
+			keys:        []int{10, 20, 30},
+			priorities:  []int{6, 8, 2},
+			pops:        2,
+			expectedLen: 1,
+		},
+		"queue with duplicate keys": {
+			keys:        []int{10, 10, 20},
+			priorities:  []int{6, 8, 2},
+			expectedLen: 2,
+		},
 		"empty queue": {
-			items:       []int{},
-			priorities:  []float64{},
+			keys:        []int{},
+			priorities:  []int{},
 			expectedLen: 0,
 		},
 	}
 
 	for name, test := range tests {
-		queue := newPriorityQueue[int]()
+		queue := &PriorityQueue[int]{}
 
-		for i, item := range test.items {
-			queue.Push(item, test.priorities[i])
+		for i, key := range test.keys {
+			queue.Push(key, test.priorities[i])
+		}
+
+		for i := 0; i < test.pops; i++ {
+			_, _ = queue.Pop()
 		}
 
 		len := queue.Len()
@@ -185,85 +204,25 @@ func TestPriorityQueue_Len(t *testing.T) {
 	}
 }
 
-func TestPriorityQueue_insertItemAt(t *testing.T) {
-	tests := map[string]struct {
-		items                 []priorityItem[int]
-		expectedPriorityItems []priorityItem[int]
-		insertItem            int
-		insertPriority        float64
-		insertIndex           int
-	}{
-		"insert in the middle of the queue": {
-			items: []priorityItem[int]{
-				{value: 10, priority: 10},
-				{value: 20, priority: 20},
-				{value: 30, priority: 30},
-				{value: 40, priority: 40},
-			},
-			insertItem:     25,
-			insertPriority: 25,
-			insertIndex:    2,
-			expectedPriorityItems: []priorityItem[int]{
-				{value: 10, priority: 10},
-				{value: 20, priority: 20},
-				{value: 25, priority: 25},
-				{value: 30, priority: 30},
-				{value: 40, priority: 40},
-			},
-		},
-		"insert at the start of the queue": {
-			items: []priorityItem[int]{
-				{value: 10, priority: 10},
-				{value: 20, priority: 20},
-				{value: 30, priority: 30},
-				{value: 40, priority: 40},
-			},
-			insertItem:     5,
-			insertPriority: 5,
-			insertIndex:    0,
-			expectedPriorityItems: []priorityItem[int]{
-				{value: 5, priority: 5},
-				{value: 10, priority: 10},
-				{value: 20, priority: 20},
-				{value: 30, priority: 30},
-				{value: 40, priority: 40},
-			},
-		},
-		"insert at the end of the queue": {
-			items: []priorityItem[int]{
-				{value: 10, priority: 10},
-				{value: 20, priority: 20},
-				{value: 30, priority: 30},
-				{value: 40, priority: 40},
-			},
-			insertItem:     50,
-			insertPriority: 50,
-			insertIndex:    4,
-			expectedPriorityItems: []priorityItem[int]{
-				{value: 10, priority: 10},
-				{value: 20, priority: 20},
-				{value: 30, priority: 30},
-				{value: 40, priority: 40},
-				{value: 50, priority: 50},
-			},
-		},
-	}
+type (
+	namedInt    int
+	namedString string
+)
 
-	for name, test := range tests {
-		queue := &priorityQueue[int]{
-			items: test.items,
-		}
+// TestSortKeys_NamedTypes checks that hashes of named types are sorted by their natural order rather
+// than by their string representation, which would place 10 before 9.
+func TestSortKeys_NamedTypes(t *testing.T) {
+	ints := []namedInt{10, 9, -1}
+	sortKeys(ints)
 
-		queue.insertItemAt(test.insertItem, test.insertPriority, test.insertIndex)
+	if expected := []namedInt{-1, 9, 10}; !orderedSlicesAreEqual(ints, expected) {
+		t.Errorf("key expectancy doesn't match: expected %v, got %v", expected, ints)
+	}
 
-		if len(queue.items) != len(test.expectedPriorityItems) {
-			t.Fatalf("%s: item length expectancy doesn't match: expected %v, got %v", name, len(test.expectedPriorityItems), len(queue.items))
-		}
+	strings := []namedString{"b", "B", "a"}
+	sortKeys(strings)
 
-		for i, expectedPriorityItem := range test.expectedPriorityItems {
-			if queue.items[i] != expectedPriorityItem {
-				t.Errorf("%s: item doesn't match: expected %v at index %d, got %v", name, expectedPriorityItem, i, queue.items[i])
-			}
-		}
+	if expected := []namedString{"B", "a", "b"}; !orderedSlicesAreEqual(strings, expected) {
+		t.Errorf("key expectancy doesn't match: expected %v, got %v", expected, strings)
 	}
 }
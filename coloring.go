@@ -0,0 +1,226 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ColoringK tries to find a proper vertex coloring of the graph that uses at most k colors. In a
+// proper coloring, no two adjacent vertices have the same color. The colors are represented by the
+// integers 0 to k-1.
+//
+// If such a coloring exists, ColoringK returns the color of each vertex and true. Otherwise, it
+// returns nil and false. Edge directions are ignored, and a vertex with a self-loop cannot be
+// colored at all. A bipartite graph can always be colored with 2 colors.
+//
+// Determining whether a k-coloring exists is NP-hard, and ColoringK uses backtracking, which takes
+// exponential time in the worst case. Use ColoringKContext to be able to cancel the search.
+func ColoringK[K comparable, T any](g Graph[K, T], k int) (map[K]int, bool, error) {
+	return ColoringKContext(context.Background(), g, k)
+}
+
+// ColoringKContext works like ColoringK, but stops the search and returns the context's error as
+// soon as the given context is canceled.
+func ColoringKContext[K comparable, T any](ctx context.Context, g Graph[K, T], k int) (map[K]int, bool, error) {
+	if k < 0 {
+		return nil, false, fmt.Errorf("number of colors must not be negative, got %d", k)
+	}
+
+	neighbours, err := undirectedNeighbours(g)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for vertex := range neighbours {
+		if neighbours[vertex][vertex] {
+			return nil, false, nil
+		}
+	}
+
+	// Coloring the vertices with the most neighbours first detects conflicts early and prunes
+	// large parts of the search tree.
+	vertices := make([]K, 0, len(neighbours))
+	for vertex := range neighbours {
+		vertices = append(vertices, vertex)
+	}
+
+	sortKeys(vertices)
+
+	sort.SliceStable(vertices, func(i, j int) bool {
+		return len(neighbours[vertices[i]]) > len(neighbours[vertices[j]])
+	})
+
+	colors := make(map[K]int, len(vertices))
+
+	var assign func(index int) (bool, error)
+
+	assign = func(index int) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		if index == len(vertices) {
+			return true, nil
+		}
+
+		vertex := vertices[index]
+
+		for color := 0; color < k; color++ {
+			isValid := true
+
+			for neighbour := range neighbours[vertex] {
+				if neighbourColor, ok := colors[neighbour]; ok && neighbourColor == color {
+					isValid = false
+					break
+				}
+			}
+
+			if !isValid {
+				continue
+			}
+
+			colors[vertex] = color
+
+			found, err := assign(index + 1)
+			if err != nil || found {
+				return found, err
+			}
+
+			delete(colors, vertex)
+		}
+
+		return false, nil
+	}
+
+	found, err := assign(0)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	return colors, true, nil
+}
+
+// MaximumIndependentSet finds a maximum independent set of the graph and returns the hashes of its
+// vertices in ascending order. An independent set is a set of vertices of which no two are
+// adjacent, and a maximum independent set is an independent set with the largest possible number
+// of vertices. For a complete graph, it consists of a single vertex.
+//
+// Edge directions are ignored, and a vertex with a self-loop is never part of the set. An
+// independent set of a graph is a clique of its complement graph, so MaximumIndependentSet can be
+// used to find a maximum clique as well.
+//
+// Finding a maximum independent set is NP-hard, and MaximumIndependentSet uses a branch-and-bound
+// search, which takes exponential time in the worst case and is only suitable for small graphs.
+// Use MaximumIndependentSetContext to be able to cancel the search.
+func MaximumIndependentSet[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	return MaximumIndependentSetContext(context.Background(), g)
+}
+
+// MaximumIndependentSetContext works like MaximumIndependentSet, but stops the search and returns
+// the context's error as soon as the given context is canceled.
+func MaximumIndependentSetContext[K comparable, T any](ctx context.Context, g Graph[K, T]) ([]K, error) {
+	neighbours, err := undirectedNeighbours(g)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]K, 0, len(neighbours))
+	for vertex := range neighbours {
+		if !neighbours[vertex][vertex] {
+			candidates = append(candidates, vertex)
+		}
+	}
+
+	sortKeys(candidates)
+
+	best := make([]K, 0)
+
+	var search func(chosen, candidates []K) error
+
+	search = func(chosen, candidates []K) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// Even choosing all remaining candidates can't beat the best set found so far.
+		if len(chosen)+len(candidates) <= len(best) {
+			return nil
+		}
+
+		// Branching on the candidate with the most neighbours among the other candidates removes
+		// as many candidates as possible when it is chosen.
+		pivot, maxDegree := -1, 0
+		for i, candidate := range candidates {
+			degree := 0
+			for _, other := range candidates {
+				if neighbours[candidate][other] {
+					degree++
+				}
+			}
+			if degree > maxDegree {
+				pivot, maxDegree = i, degree
+			}
+		}
+
+		// If none of the candidates are adjacent, all of them can be chosen.
+		if pivot == -1 {
+			best = append(append(make([]K, 0, len(chosen)+len(candidates)), chosen...), candidates...)
+			return nil
+		}
+
+		vertex := candidates[pivot]
+
+		remaining := make([]K, 0, len(candidates))
+		for _, candidate := range candidates {
+			if candidate != vertex && !neighbours[vertex][candidate] {
+				remaining = append(remaining, candidate)
+			}
+		}
+
+		if err := search(append(chosen[:len(chosen):len(chosen)], vertex), remaining); err != nil {
+			return err
+		}
+
+		remaining = append(candidates[:pivot:pivot], candidates[pivot+1:]...)
+
+		return search(chosen, remaining)
+	}
+
+	if err := search(nil, candidates); err != nil {
+		return nil, err
+	}
+
+	sortKeys(best)
+
+	return best, nil
+}
+
+// undirectedNeighbours returns the neighbours of each vertex in the graph, regardless of the edge
+// directions. In a directed graph, the neighbours of a vertex are both its successors and its
+// predecessors.
+func undirectedNeighbours[K comparable, T any](g Graph[K, T]) (map[K]map[K]bool, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	neighbours := make(map[K]map[K]bool, len(adjacencyMap))
+
+	for vertex := range adjacencyMap {
+		neighbours[vertex] = make(map[K]bool)
+	}
+
+	for vertex, adjacencies := range adjacencyMap {
+		for adjacency := range adjacencies {
+			neighbours[vertex][adjacency] = true
+			neighbours[adjacency][vertex] = true
+		}
+	}
+
+	return neighbours, nil
+}
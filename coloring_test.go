@@ -0,0 +1,304 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestColoringK(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []int
+		edges         []Edge[int]
+		k             int
+		expectedFound bool
+	}{
+		"bipartite graph with 2 colors": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 4},
+				{Source: 1, Target: 5},
+				{Source: 2, Target: 5},
+				{Source: 2, Target: 6},
+				{Source: 3, Target: 4},
+				{Source: 3, Target: 6},
+			},
+			k:             2,
+			expectedFound: true,
+		},
+		"triangle with 2 colors": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			k:             2,
+			expectedFound: false,
+		},
+		"directed triangle with 3 colors": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			k:             3,
+			expectedFound: true,
+		},
+		"complete graph with 4 vertices and 3 colors": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			k:             3,
+			expectedFound: false,
+		},
+		"self-loop": {
+			vertices: []int{1},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+			},
+			k:             5,
+			expectedFound: false,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		colors, found, err := ColoringK(graph, test.k)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if found != test.expectedFound {
+			t.Fatalf("%s: found expectancy doesn't match: expected %v, got %v", name, test.expectedFound, found)
+		}
+
+		if !found {
+			continue
+		}
+
+		if len(colors) != len(test.vertices) {
+			t.Errorf("%s: color count expectancy doesn't match: expected %v, got %v", name, len(test.vertices), len(colors))
+		}
+
+		for _, edge := range test.edges {
+			if colors[edge.Source] == colors[edge.Target] {
+				t.Errorf("%s: adjacent vertices %v and %v have the same color", name, edge.Source, edge.Target)
+			}
+		}
+
+		for vertex, color := range colors {
+			if color < 0 || color >= test.k {
+				t.Errorf("%s: color of %v out of range: %v", name, vertex, color)
+			}
+		}
+	}
+}
+
+func TestColoringKContext(t *testing.T) {
+	graph := New(IntHash)
+
+	for i := 1; i <= 3; i++ {
+		_ = graph.AddVertex(i)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := ColoringKContext(ctx, graph, 2)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error expectancy doesn't match: expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestMaximumIndependentSet(t *testing.T) {
+	tests := map[string]struct {
+		isDirected  bool
+		vertices    []int
+		edges       []Edge[int]
+		expectedSet []int
+	}{
+		"complete graph": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expectedSet: []int{1},
+		},
+		"star graph": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expectedSet: []int{2, 3, 4},
+		},
+		"path graph": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			expectedSet: []int{1, 3, 5},
+		},
+		"directed graph with a self-loop": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 2, Target: 3},
+			},
+			expectedSet: []int{2},
+		},
+		"graph without edges": {
+			vertices:    []int{1, 2, 3},
+			expectedSet: []int{1, 2, 3},
+		},
+		"empty graph": {
+			expectedSet: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		set, err := MaximumIndependentSet(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if !orderedSlicesAreEqual(set, test.expectedSet) {
+			t.Errorf("%s: set expectancy doesn't match: expected %v, got %v", name, test.expectedSet, set)
+		}
+	}
+}
+
+// TestMaximumIndependentSet_Random compares the size of the independent set found on random
+// graphs with the size found by trying all subsets of vertices.
+func TestMaximumIndependentSet_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for round := 0; round < 50; round++ {
+		n := 10
+		graph := New(IntHash)
+
+		for i := 0; i < n; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		for i := 0; i < 2*n; i++ {
+			_ = graph.AddEdge(rng.Intn(n), rng.Intn(n))
+		}
+
+		set, err := MaximumIndependentSet(graph)
+		if err != nil {
+			t.Fatalf("round %d: unexpected error: %s", round, err.Error())
+		}
+
+		for i, a := range set {
+			for _, b := range set[i:] {
+				if _, err := graph.Edge(a, b); err == nil {
+					t.Fatalf("round %d: set %v contains adjacent vertices %v and %v", round, set, a, b)
+				}
+			}
+		}
+
+		expectedSize := 0
+
+		for subset := 0; subset < 1<<n; subset++ {
+			isIndependent := true
+			size := 0
+
+			for a := 0; a < n && isIndependent; a++ {
+				if subset&(1<<a) == 0 {
+					continue
+				}
+				size++
+				for b := a; b < n; b++ {
+					if subset&(1<<b) == 0 {
+						continue
+					}
+					if _, err := graph.Edge(a, b); err == nil {
+						isIndependent = false
+						break
+					}
+				}
+			}
+
+			if isIndependent && size > expectedSize {
+				expectedSize = size
+			}
+		}
+
+		if len(set) != expectedSize {
+			t.Errorf("round %d: set size expectancy doesn't match: expected %v, got %v", round, expectedSize, len(set))
+		}
+	}
+}
+
+func TestMaximumIndependentSetContext(t *testing.T) {
+	graph := New(IntHash)
+
+	for i := 1; i <= 3; i++ {
+		_ = graph.AddVertex(i)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := MaximumIndependentSetContext(ctx, graph)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error expectancy doesn't match: expected %v, got %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,290 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TwoEdgeConnectedComponents detects all 2-edge-connected components in an undirected graph and
+// returns the hashes of the vertices shaping these components. A 2-edge-connected component is a
+// maximal subgraph that stays connected when any single edge is removed, i.e. a maximal subgraph
+// without bridges.
+//
+// The components are obtained by removing all bridges from the graph and by determining the
+// connected components of the remaining graph. A vertex that is only joined with the rest of the
+// graph by bridges forms a component on its own.
+func TwoEdgeConnectedComponents[K comparable, T any](g Graph[K, T]) ([][]K, error) {
+	if g.Traits().IsDirected {
+		return nil, errors.New("2-edge-connected components can only be detected in undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	bridgeEdges := bridges(adjacencyMap)
+
+	isBridge := func(a, b K) bool {
+		return bridgeEdges[a][b] || bridgeEdges[b][a]
+	}
+
+	components := make([][]K, 0)
+	visited := make(map[K]bool)
+
+	for vertex := range adjacencyMap {
+		if visited[vertex] {
+			continue
+		}
+
+		component := []K{vertex}
+		visited[vertex] = true
+		queue := []K{vertex}
+
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			for adjacency := range adjacencyMap[current] {
+				if visited[adjacency] || isBridge(current, adjacency) {
+					continue
+				}
+				visited[adjacency] = true
+				component = append(component, adjacency)
+				queue = append(queue, adjacency)
+			}
+		}
+
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+// bridges detects all bridges in the undirected graph represented by the given adjacency map using
+// Tarjan's bridge-finding algorithm. A bridge is an edge whose removal increases the number of
+// connected components. The returned map contains an entry bridges[u][v] for each bridge (u, v),
+// where u is the vertex that has been discovered first.
+func bridges[K comparable](adjacencyMap map[K]map[K]Edge[K]) map[K]map[K]bool {
+	result := make(map[K]map[K]bool)
+	discovery := make(map[K]int)
+	lowlink := make(map[K]int)
+	time := 0
+
+	var visit func(vertex, parent K, isRoot bool)
+
+	visit = func(vertex, parent K, isRoot bool) {
+		discovery[vertex] = time
+		lowlink[vertex] = time
+		time++
+
+		for adjacency := range adjacencyMap[vertex] {
+			if adjacency == vertex || (!isRoot && adjacency == parent) {
+				continue
+			}
+
+			if _, ok := discovery[adjacency]; ok {
+				if discovery[adjacency] < lowlink[vertex] {
+					lowlink[vertex] = discovery[adjacency]
+				}
+				continue
+			}
+
+			visit(adjacency, vertex, false)
+
+			if lowlink[adjacency] < lowlink[vertex] {
+				lowlink[vertex] = lowlink[adjacency]
+			}
+
+			// If the adjacent vertex cannot reach the current vertex or any vertex discovered
+			// before it without using the edge between them, that edge is a bridge.
+			if lowlink[adjacency] > discovery[vertex] {
+				if _, ok := result[vertex]; !ok {
+					result[vertex] = make(map[K]bool)
+				}
+				result[vertex][adjacency] = true
+			}
+		}
+	}
+
+	for vertex := range adjacencyMap {
+		if _, ok := discovery[vertex]; !ok {
+			visit(vertex, vertex, true)
+		}
+	}
+
+	return result
+}
+
+// BiconnectedComponents detects all biconnected components, also known as blocks, in an undirected
+// graph. A biconnected component is a maximal subgraph that stays connected when any single vertex
+// is removed. Each component is returned as the set of its edges, where each edge is represented
+// by the hashes of its two vertices.
+//
+// Every edge belongs to exactly one component, whereas an articulation point belongs to each of the
+// components that it joins. A bridge forms a component on its own, consisting of a single edge
+// between two vertices. Isolated vertices and self-loops are not part of any component.
+//
+// The current implementation uses the stack-based DFS algorithm by Hopcroft and Tarjan and runs
+// recursively.
+func BiconnectedComponents[K comparable, T any](g Graph[K, T]) ([][][2]K, error) {
+	if g.Traits().IsDirected {
+		return nil, errors.New("biconnected components can only be detected in undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	components := make([][][2]K, 0)
+	discovery := make(map[K]int)
+	lowlink := make(map[K]int)
+	stack := make([][2]K, 0)
+	time := 0
+
+	var visit func(vertex, parent K, isRoot bool)
+
+	visit = func(vertex, parent K, isRoot bool) {
+		discovery[vertex] = time
+		lowlink[vertex] = time
+		time++
+
+		for adjacency := range adjacencyMap[vertex] {
+			if adjacency == vertex || (!isRoot && adjacency == parent) {
+				continue
+			}
+
+			if _, ok := discovery[adjacency]; ok {
+				// Only push back edges leading to an ancestor, since the edge has already been
+				// pushed from the other side otherwise.
+				if discovery[adjacency] < discovery[vertex] {
+					stack = append(stack, [2]K{vertex, adjacency})
+					if discovery[adjacency] < lowlink[vertex] {
+						lowlink[vertex] = discovery[adjacency]
+					}
+				}
+				continue
+			}
+
+			stack = append(stack, [2]K{vertex, adjacency})
+			visit(adjacency, vertex, false)
+
+			if lowlink[adjacency] < lowlink[vertex] {
+				lowlink[vertex] = lowlink[adjacency]
+			}
+
+			// If the adjacent vertex cannot reach any vertex discovered before the current vertex,
+			// the current vertex separates the edges pushed since (vertex, adjacency) from the
+			// rest of the graph, so they form a component.
+			if lowlink[adjacency] >= discovery[vertex] {
+				var component [][2]K
+
+				for {
+					edge := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					component = append(component, edge)
+
+					if edge[0] == vertex && edge[1] == adjacency {
+						break
+					}
+				}
+
+				components = append(components, component)
+			}
+		}
+	}
+
+	for vertex := range adjacencyMap {
+		if _, ok := discovery[vertex]; !ok {
+			visit(vertex, vertex, true)
+		}
+	}
+
+	return components, nil
+}
+
+// ComponentIndex answers queries about the connected components of a graph in constant time. For
+// an undirected graph, it indexes the connected components, and for a directed graph, it indexes
+// the weakly connected components, i.e. edge directions are ignored.
+//
+// The index is a snapshot of the graph at the time of its creation and doesn't reflect subsequent
+// changes to the graph. Use NewComponentIndex to create an index.
+type ComponentIndex[K comparable] struct {
+	components map[K]int
+}
+
+// NewComponentIndex determines the connected components of the given graph and creates an index
+// over them. The components are numbered from 0 in ascending order of their smallest vertex hash.
+func NewComponentIndex[K comparable, T any](g Graph[K, T]) (*ComponentIndex[K], error) {
+	neighbours, err := undirectedNeighbours(g)
+	if err != nil {
+		return nil, err
+	}
+
+	vertices := make([]K, 0, len(neighbours))
+	for vertex := range neighbours {
+		vertices = append(vertices, vertex)
+	}
+
+	sortKeys(vertices)
+
+	components := make(map[K]int, len(vertices))
+	component := 0
+
+	for _, vertex := range vertices {
+		if _, ok := components[vertex]; ok {
+			continue
+		}
+
+		components[vertex] = component
+		queue := []K{vertex}
+
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			for neighbour := range neighbours[current] {
+				if _, ok := components[neighbour]; ok {
+					continue
+				}
+
+				components[neighbour] = component
+				queue = append(queue, neighbour)
+			}
+		}
+
+		component++
+	}
+
+	return &ComponentIndex[K]{
+		components: components,
+	}, nil
+}
+
+// SameComponent reports whether the two given vertices are in the same connected component. It
+// returns false if either vertex isn't contained in the index.
+func (c *ComponentIndex[K]) SameComponent(a, b K) bool {
+	aComponent, ok := c.components[a]
+	if !ok {
+		return false
+	}
+
+	bComponent, ok := c.components[b]
+	if !ok {
+		return false
+	}
+
+	return aComponent == bComponent
+}
+
+// ComponentOf returns the number of the connected component containing the given vertex, or -1 if
+// the vertex isn't contained in the index.
+func (c *ComponentIndex[K]) ComponentOf(vertex K) int {
+	component, ok := c.components[vertex]
+	if !ok {
+		return -1
+	}
+
+	return component
+}
@@ -0,0 +1,254 @@
+package graph
+
+import "testing"
+
+func TestTwoEdgeConnectedComponents(t *testing.T) {
+	tests := map[string]struct {
+		vertices           []int
+		edges              []Edge[int]
+		expectedComponents [][]int
+	}{
+		"two triangles joined by a bridge": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 4},
+			},
+			expectedComponents: [][]int{{1, 2, 3}, {4, 5, 6}},
+		},
+		"path graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedComponents: [][]int{{1}, {2}, {3}},
+		},
+		"cycle with isolated vertex": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			expectedComponents: [][]int{{1, 2, 3, 4}, {5}},
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		components, err := TwoEdgeConnectedComponents(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if !componentsAreEqual(components, test.expectedComponents) {
+			t.Errorf("%s: components expectancy doesn't match: expected %v, got %v", name, test.expectedComponents, components)
+		}
+	}
+}
+
+func TestDirectedTwoEdgeConnectedComponents(t *testing.T) {
+	graph := New(IntHash, Directed())
+
+	if _, err := TwoEdgeConnectedComponents(graph); err == nil {
+		t.Errorf("expected error for directed graph")
+	}
+}
+
+// componentsAreEqual determines whether two sets of components contain the same components,
+// regardless of the order of the components and of the vertices within a component.
+func componentsAreEqual[K comparable](a, b [][]K) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, aComponent := range a {
+		found := false
+		for _, bComponent := range b {
+			if slicesAreEqual(aComponent, bComponent) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestBiconnectedComponents(t *testing.T) {
+	tests := map[string]struct {
+		vertices           []int
+		edges              []Edge[int]
+		expectedComponents [][][2]int
+	}{
+		"two triangles sharing a single vertex": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 3},
+			},
+			expectedComponents: [][][2]int{
+				{{1, 2}, {2, 3}, {3, 1}},
+				{{3, 4}, {4, 5}, {5, 3}},
+			},
+		},
+		"triangle with a bridge and an isolated vertex": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+			},
+			expectedComponents: [][][2]int{
+				{{1, 2}, {2, 3}, {3, 1}},
+				{{3, 4}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		components, err := BiconnectedComponents(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if len(components) != len(test.expectedComponents) {
+			t.Fatalf("%s: component count expectancy doesn't match: expected %v, got %v", name, test.expectedComponents, components)
+		}
+
+		for _, expectedComponent := range test.expectedComponents {
+			found := false
+			for _, component := range components {
+				if slicesAreEqualWithFunc(component, expectedComponent, undirectedEdgesAreEqual) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("%s: expected component %v not found in %v", name, expectedComponent, components)
+			}
+		}
+	}
+}
+
+func undirectedEdgesAreEqual(a, b [2]int) bool {
+	return a == b || (a[0] == b[1] && a[1] == b[0])
+}
+
+func TestComponentIndex(t *testing.T) {
+	tests := map[string]struct {
+		isDirected          bool
+		vertices            []int
+		edges               []Edge[int]
+		expectedComponents  map[int]int
+		sameComponent       [][2]int
+		differentComponents [][2]int
+	}{
+		"undirected graph with two components": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 4, Target: 5},
+			},
+			expectedComponents: map[int]int{1: 0, 2: 0, 3: 0, 4: 1, 5: 1},
+			sameComponent:      [][2]int{{1, 3}, {5, 4}, {2, 2}},
+			differentComponents: [][2]int{
+				{1, 4},
+				{3, 5},
+				{1, 6},
+			},
+		},
+		"weakly connected directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 2},
+			},
+			expectedComponents: map[int]int{1: 0, 2: 0, 3: 0, 4: 1, 7: -1},
+			sameComponent:      [][2]int{{1, 3}, {3, 1}},
+			differentComponents: [][2]int{
+				{4, 1},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		index, err := NewComponentIndex(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		for vertex, expectedComponent := range test.expectedComponents {
+			if component := index.ComponentOf(vertex); component != expectedComponent {
+				t.Errorf("%s: component expectancy of %v doesn't match: expected %v, got %v", name, vertex, expectedComponent, component)
+			}
+		}
+
+		for _, pair := range test.sameComponent {
+			if !index.SameComponent(pair[0], pair[1]) {
+				t.Errorf("%s: %v and %v are expected to be in the same component", name, pair[0], pair[1])
+			}
+		}
+
+		for _, pair := range test.differentComponents {
+			if index.SameComponent(pair[0], pair[1]) {
+				t.Errorf("%s: %v and %v are expected to be in different components", name, pair[0], pair[1])
+			}
+		}
+	}
+}
@@ -0,0 +1,351 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// witnessSearchLimit is the maximum number of vertices settled by a single witness search during
+// the preprocessing of a contraction hierarchy. Stopping a witness search early never affects the
+// correctness of queries, it only leads to more shortcut edges.
+const witnessSearchLimit = 500
+
+// ContractionHierarchy is a preprocessed representation of a graph that answers shortest path
+// queries much faster than a plain Dijkstra search. It is created using NewContractionHierarchy.
+//
+// A contraction hierarchy doesn't reflect changes made to the graph after its creation.
+type ContractionHierarchy[K comparable] struct {
+	rank map[K]int
+	// upward contains the edges from each vertex to vertices with a higher rank.
+	upward map[K]map[K]int
+	// downward contains the edges into each vertex from vertices with a higher rank, keyed by the
+	// higher-ranked vertex.
+	downward map[K]map[K]int
+	// shortcuts maps the shortcut edges to the contracted vertex they bypass.
+	shortcuts map[K]map[K]K
+}
+
+// NewContractionHierarchy preprocesses the given graph into a contraction hierarchy. Vertices are
+// contracted one after another, ordered by their edge difference, and shortcut edges are inserted
+// wherever a contraction would destroy a shortest path.
+//
+// The preprocessing is considerably more expensive than a single shortest path search and pays
+// off when many queries are run against the same graph. If the graph is not weighted, each edge
+// counts as a distance of 1. Negative edge weights are not supported.
+func NewContractionHierarchy[K comparable, T any](g Graph[K, T]) (*ContractionHierarchy[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	isWeighted := g.Traits().IsWeighted
+
+	// out and in hold the edges of the remaining, not yet contracted graph.
+	out := make(map[K]map[K]int, len(adjacencyMap))
+	in := make(map[K]map[K]int, len(adjacencyMap))
+
+	for vertex := range adjacencyMap {
+		out[vertex] = make(map[K]int)
+		in[vertex] = make(map[K]int)
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			if source == target {
+				continue
+			}
+
+			weight := 1
+			if isWeighted {
+				weight = edge.Properties.Weight
+			}
+
+			if weight < 0 {
+				return nil, fmt.Errorf("edge (%v, %v) has a negative weight", source, target)
+			}
+
+			if existing, ok := out[source][target]; !ok || weight < existing {
+				out[source][target] = weight
+				in[target][source] = weight
+			}
+		}
+	}
+
+	ch := &ContractionHierarchy[K]{
+		rank:      make(map[K]int, len(adjacencyMap)),
+		upward:    make(map[K]map[K]int, len(adjacencyMap)),
+		downward:  make(map[K]map[K]int, len(adjacencyMap)),
+		shortcuts: make(map[K]map[K]K),
+	}
+
+	contractedNeighbours := make(map[K]int, len(adjacencyMap))
+
+	priority := func(vertex K) int {
+		shortcuts := contractionShortcuts(out, in, vertex)
+		return len(shortcuts) - len(out[vertex]) - len(in[vertex]) + contractedNeighbours[vertex]
+	}
+
+	queue := &weightQueue[K, int]{}
+
+	for _, vertex := range sortedMapKeys(adjacencyMap) {
+		heap.Push(queue, weightItem[K, int]{hash: vertex, weight: priority(vertex)})
+	}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(weightItem[K, int])
+
+		// The priorities are updated lazily: If the priority of the vertex has grown since it has
+		// been queued, it is queued again unless it still is the best candidate.
+		if current := priority(item.hash); queue.Len() > 0 && current > (*queue)[0].weight {
+			heap.Push(queue, weightItem[K, int]{hash: item.hash, weight: current})
+			continue
+		}
+
+		vertex := item.hash
+		ch.rank[vertex] = len(ch.rank)
+		ch.upward[vertex] = out[vertex]
+		ch.downward[vertex] = in[vertex]
+
+		for _, shortcut := range contractionShortcuts(out, in, vertex) {
+			if existing, ok := out[shortcut.source][shortcut.target]; ok && existing <= shortcut.weight {
+				continue
+			}
+
+			out[shortcut.source][shortcut.target] = shortcut.weight
+			in[shortcut.target][shortcut.source] = shortcut.weight
+
+			if _, ok := ch.shortcuts[shortcut.source]; !ok {
+				ch.shortcuts[shortcut.source] = make(map[K]K)
+			}
+			ch.shortcuts[shortcut.source][shortcut.target] = vertex
+		}
+
+		for target := range out[vertex] {
+			delete(in[target], vertex)
+			contractedNeighbours[target]++
+		}
+
+		for source := range in[vertex] {
+			delete(out[source], vertex)
+			contractedNeighbours[source]++
+		}
+
+		delete(out, vertex)
+		delete(in, vertex)
+	}
+
+	return ch, nil
+}
+
+// Query computes the shortest path between the source and the target vertex and returns the hash
+// values of the vertices forming that path along with its total weight. The path includes the
+// source and the target vertex.
+//
+// If the target cannot be reached from the source vertex, an error wrapping ErrTargetNotReachable
+// will be returned.
+func (c *ContractionHierarchy[K]) Query(source, target K) ([]K, int, error) {
+	if _, ok := c.rank[source]; !ok {
+		return nil, 0, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	if _, ok := c.rank[target]; !ok {
+		return nil, 0, fmt.Errorf("could not find target vertex with hash %v", target)
+	}
+
+	forward := newCHSearch(source)
+	backward := newCHSearch(target)
+
+	best := -1
+	var meeting K
+
+	for forward.queue.Len() > 0 || backward.queue.Len() > 0 {
+		if forward.queue.Len() > 0 {
+			if vertex, ok := forward.settle(c.upward, best); ok {
+				if distance, ok := backward.distances[vertex]; ok {
+					if total := forward.distances[vertex] + distance; best < 0 || total < best {
+						best = total
+						meeting = vertex
+					}
+				}
+			}
+		}
+
+		if backward.queue.Len() > 0 {
+			if vertex, ok := backward.settle(c.downward, best); ok {
+				if distance, ok := forward.distances[vertex]; ok {
+					if total := backward.distances[vertex] + distance; best < 0 || total < best {
+						best = total
+						meeting = vertex
+					}
+				}
+			}
+		}
+	}
+
+	if best < 0 {
+		return nil, 0, fmt.Errorf("%w: %v -> %v", ErrTargetNotReachable, source, target)
+	}
+
+	var upwardPath []K
+	for vertex := meeting; vertex != source; vertex = forward.predecessors[vertex] {
+		upwardPath = append(upwardPath, vertex)
+	}
+	upwardPath = append(upwardPath, source)
+
+	path := []K{source}
+
+	for i := len(upwardPath) - 1; i > 0; i-- {
+		path = c.unpack(path, upwardPath[i], upwardPath[i-1])
+	}
+
+	for vertex := meeting; vertex != target; vertex = backward.predecessors[vertex] {
+		path = c.unpack(path, vertex, backward.predecessors[vertex])
+	}
+
+	return path, best, nil
+}
+
+// unpack appends the vertices of the edge (source, target) to the given path, which has to end
+// with source. Shortcut edges are recursively replaced with the edges they bypass.
+func (c *ContractionHierarchy[K]) unpack(path []K, source, target K) []K {
+	if via, ok := c.shortcuts[source][target]; ok {
+		path = c.unpack(path, source, via)
+		return c.unpack(path, via, target)
+	}
+
+	return append(path, target)
+}
+
+// chSearch is one direction of the bidirectional Dijkstra search in a contraction hierarchy.
+type chSearch[K comparable] struct {
+	distances    map[K]int
+	predecessors map[K]K
+	settled      map[K]bool
+	queue        *weightQueue[K, int]
+}
+
+func newCHSearch[K comparable](start K) *chSearch[K] {
+	s := &chSearch[K]{
+		distances:    map[K]int{start: 0},
+		predecessors: make(map[K]K),
+		settled:      make(map[K]bool),
+		queue:        &weightQueue[K, int]{},
+	}
+
+	heap.Push(s.queue, weightItem[K, int]{hash: start, weight: 0})
+
+	return s
+}
+
+// settle settles the next vertex and relaxes its edges. Once the search has exceeded the best
+// known distance, the queue is drained since no shorter path can be found in this direction.
+func (s *chSearch[K]) settle(edges map[K]map[K]int, best int) (K, bool) {
+	item := heap.Pop(s.queue).(weightItem[K, int])
+
+	if best >= 0 && item.weight >= best {
+		*s.queue = (*s.queue)[:0]
+		return item.hash, false
+	}
+
+	if s.settled[item.hash] {
+		return item.hash, false
+	}
+	s.settled[item.hash] = true
+
+	for adjacency, weight := range edges[item.hash] {
+		distance := item.weight + weight
+		if existing, ok := s.distances[adjacency]; ok && existing <= distance {
+			continue
+		}
+
+		s.distances[adjacency] = distance
+		s.predecessors[adjacency] = item.hash
+		heap.Push(s.queue, weightItem[K, int]{hash: adjacency, weight: distance})
+	}
+
+	return item.hash, true
+}
+
+// contractionShortcut is an edge that needs to be inserted when a vertex is contracted.
+type contractionShortcut[K comparable] struct {
+	source K
+	target K
+	weight int
+}
+
+// contractionShortcuts determines the shortcuts required for contracting the given vertex from
+// the remaining graph. A shortcut (u, w) is only required if there is no witness path from u to w
+// that avoids the vertex and is at most as long as the path through it.
+func contractionShortcuts[K comparable](out, in map[K]map[K]int, vertex K) []contractionShortcut[K] {
+	var shortcuts []contractionShortcut[K]
+
+	for _, source := range sortedMapKeys(in[vertex]) {
+		maxDistance := 0
+		for target, weight := range out[vertex] {
+			if target != source && in[vertex][source]+weight > maxDistance {
+				maxDistance = in[vertex][source] + weight
+			}
+		}
+
+		distances := witnessSearch(out, source, vertex, maxDistance)
+
+		for _, target := range sortedMapKeys(out[vertex]) {
+			if target == source {
+				continue
+			}
+
+			viaDistance := in[vertex][source] + out[vertex][target]
+
+			if distance, ok := distances[target]; ok && distance <= viaDistance {
+				continue
+			}
+
+			shortcuts = append(shortcuts, contractionShortcut[K]{
+				source: source,
+				target: target,
+				weight: viaDistance,
+			})
+		}
+	}
+
+	return shortcuts
+}
+
+// witnessSearch runs a Dijkstra search from the source vertex that ignores the excluded vertex and
+// stops at the given maximum distance or after settling witnessSearchLimit vertices.
+func witnessSearch[K comparable](out map[K]map[K]int, source, excluded K, maxDistance int) map[K]int {
+	distances := map[K]int{source: 0}
+	settled := make(map[K]bool)
+
+	queue := &weightQueue[K, int]{}
+	heap.Push(queue, weightItem[K, int]{hash: source, weight: 0})
+
+	for queue.Len() > 0 && len(settled) < witnessSearchLimit {
+		item := heap.Pop(queue).(weightItem[K, int])
+
+		if item.weight > maxDistance {
+			break
+		}
+
+		if settled[item.hash] {
+			continue
+		}
+		settled[item.hash] = true
+
+		for adjacency, weight := range out[item.hash] {
+			if adjacency == excluded {
+				continue
+			}
+
+			distance := item.weight + weight
+			if existing, ok := distances[adjacency]; ok && existing <= distance {
+				continue
+			}
+
+			distances[adjacency] = distance
+			heap.Push(queue, weightItem[K, int]{hash: adjacency, weight: distance})
+		}
+	}
+
+	return distances
+}
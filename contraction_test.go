@@ -0,0 +1,319 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestContractionHierarchy_Query(t *testing.T) {
+	tests := map[string]struct {
+		isDirected     bool
+		isWeighted     bool
+		vertices       []string
+		edges          []Edge[string]
+		source         string
+		target         string
+		expectedPath   []string
+		expectedWeight int
+		shouldFail     bool
+	}{
+		"graph as on img/dijkstra.svg": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []string{"A", "B", "C", "D", "E", "F", "G"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 4}},
+				{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "E", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "E", Target: "F", Properties: EdgeProperties{Weight: 3}},
+				{Source: "F", Target: "G", Properties: EdgeProperties{Weight: 5}},
+				{Source: "G", Target: "B", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:         "A",
+			target:         "B",
+			expectedPath:   []string{"A", "C", "E", "B"},
+			expectedWeight: 6,
+		},
+		"undirected path graph": {
+			isWeighted: true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 3}},
+			},
+			source:         "D",
+			target:         "A",
+			expectedPath:   []string{"D", "C", "B", "A"},
+			expectedWeight: 6,
+		},
+		"unweighted graph": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 10}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 10}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "B", Properties: EdgeProperties{Weight: 1}},
+			},
+			source:         "A",
+			target:         "D",
+			expectedPath:   []string{"A", "B", "D"},
+			expectedWeight: 2,
+		},
+		"source equal to target": {
+			isDirected:     true,
+			vertices:       []string{"A", "B"},
+			edges:          []Edge[string]{{Source: "A", Target: "B"}},
+			source:         "A",
+			target:         "A",
+			expectedPath:   []string{"A"},
+			expectedWeight: 0,
+		},
+		"target not reachable": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C"},
+			edges:      []Edge[string]{{Source: "A", Target: "B"}},
+			source:     "A",
+			target:     "C",
+			shouldFail: true,
+		},
+		"wrong direction": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			edges:      []Edge[string]{{Source: "A", Target: "B"}},
+			source:     "B",
+			target:     "A",
+			shouldFail: true,
+		},
+		"unknown source": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			source:     "X",
+			target:     "A",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+		if test.isWeighted {
+			options = append(options, Weighted())
+		}
+
+		graph := New(StringHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		ch, err := NewContractionHierarchy(graph)
+		if err != nil {
+			t.Fatalf("%s: failed to create contraction hierarchy: %s", name, err.Error())
+		}
+
+		path, weight, err := ch.Query(test.source, test.target)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !orderedSlicesAreEqual(path, test.expectedPath) {
+			t.Errorf("%s: path expectancy doesn't match: expected %v, got %v", name, test.expectedPath, path)
+		}
+
+		if weight != test.expectedWeight {
+			t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, test.expectedWeight, weight)
+		}
+	}
+}
+
+func TestContractionHierarchy_QueryNotReachable(t *testing.T) {
+	graph := New(IntHash, Directed())
+	_ = graph.AddVertex(1)
+	_ = graph.AddVertex(2)
+
+	ch, _ := NewContractionHierarchy(graph)
+
+	if _, _, err := ch.Query(1, 2); !errors.Is(err, ErrTargetNotReachable) {
+		t.Errorf("error expectancy doesn't match: expected %v, got %v", ErrTargetNotReachable, err)
+	}
+}
+
+func TestNewContractionHierarchy_NegativeWeight(t *testing.T) {
+	graph := New(IntHash, Directed(), Weighted())
+	_ = graph.AddVertex(1)
+	_ = graph.AddVertex(2)
+	_ = graph.AddEdge(1, 2, EdgeWeight(-1))
+
+	if _, err := NewContractionHierarchy(graph); err == nil {
+		t.Error("error expectancy doesn't match: expected an error, got nil")
+	}
+}
+
+// TestContractionHierarchy_QueryRandom compares the results of all queries on random graphs with
+// the distances computed by the Floyd-Warshall algorithm.
+func TestContractionHierarchy_QueryRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, isDirected := range []bool{true, false} {
+		for round := 0; round < 20; round++ {
+			n := 20
+			options := []func(*Traits){Weighted()}
+			if isDirected {
+				options = append(options, Directed())
+			}
+
+			graph := New(IntHash, options...)
+			for i := 0; i < n; i++ {
+				_ = graph.AddVertex(i)
+			}
+
+			for i := 0; i < 3*n; i++ {
+				_ = graph.AddEdge(rng.Intn(n), rng.Intn(n), EdgeWeight(rng.Intn(10)))
+			}
+
+			expected := floydWarshallDistances(t, graph)
+
+			ch, err := NewContractionHierarchy(graph)
+			if err != nil {
+				t.Fatalf("failed to create contraction hierarchy: %s", err.Error())
+			}
+
+			for source := 0; source < n; source++ {
+				for target := 0; target < n; target++ {
+					path, weight, err := ch.Query(source, target)
+
+					distance, reachable := expected[source][target]
+					if reachable != (err == nil) {
+						t.Fatalf("%d -> %d: reachability expectancy doesn't match: expected %v, got %v", source, target, reachable, err == nil)
+					}
+
+					if !reachable {
+						continue
+					}
+
+					if weight != distance {
+						t.Fatalf("%d -> %d: weight expectancy doesn't match: expected %v, got %v", source, target, distance, weight)
+					}
+
+					if path[0] != source || path[len(path)-1] != target {
+						t.Fatalf("%d -> %d: path has wrong endpoints: %v", source, target, path)
+					}
+
+					pathWeight := 0
+					for i := 1; i < len(path); i++ {
+						edge, err := graph.Edge(path[i-1], path[i])
+						if err != nil {
+							t.Fatalf("%d -> %d: path %v contains non-existent edge (%d, %d)", source, target, path, path[i-1], path[i])
+						}
+						pathWeight += edge.Properties.Weight
+					}
+
+					if pathWeight != weight {
+						t.Fatalf("%d -> %d: path weight expectancy doesn't match: expected %v, got %v", source, target, weight, pathWeight)
+					}
+				}
+			}
+		}
+	}
+}
+
+func floydWarshallDistances(t *testing.T, g Graph[int, int]) map[int]map[int]int {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		t.Fatalf("failed to get adjacency map: %s", err.Error())
+	}
+
+	distances := make(map[int]map[int]int)
+	for source, adjacencies := range adjacencyMap {
+		distances[source] = map[int]int{source: 0}
+		for target, edge := range adjacencies {
+			if current, ok := distances[source][target]; !ok || edge.Properties.Weight < current {
+				distances[source][target] = edge.Properties.Weight
+			}
+		}
+	}
+
+	for via := range adjacencyMap {
+		for source := range adjacencyMap {
+			toVia, ok := distances[source][via]
+			if !ok {
+				continue
+			}
+			for target, fromVia := range distances[via] {
+				if current, ok := distances[source][target]; !ok || toVia+fromVia < current {
+					distances[source][target] = toVia + fromVia
+				}
+			}
+		}
+	}
+
+	return distances
+}
+
+func BenchmarkContractionHierarchy_Query(b *testing.B) {
+	graph := contractionBenchmarkGraph()
+	ch, err := NewContractionHierarchy(graph)
+	if err != nil {
+		b.Fatalf("failed to create contraction hierarchy: %s", err.Error())
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, _ = ch.Query(0, 39*40+39)
+	}
+}
+
+func BenchmarkShortestPath(b *testing.B) {
+	graph := contractionBenchmarkGraph()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ShortestPath(graph, 0, 39*40+39)
+	}
+}
+
+// contractionBenchmarkGraph creates a weighted 40x40 grid graph.
+func contractionBenchmarkGraph() Graph[int, int] {
+	rng := rand.New(rand.NewSource(42))
+	graph := New(IntHash, Weighted())
+
+	for i := 0; i < 40*40; i++ {
+		_ = graph.AddVertex(i)
+	}
+
+	for row := 0; row < 40; row++ {
+		for column := 0; column < 40; column++ {
+			vertex := row*40 + column
+			if column < 39 {
+				_ = graph.AddEdge(vertex, vertex+1, EdgeWeight(1+rng.Intn(10)))
+			}
+			if row < 39 {
+				_ = graph.AddEdge(vertex, vertex+40, EdgeWeight(1+rng.Intn(10)))
+			}
+		}
+	}
+
+	return graph
+}
@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MinimumMeanCycle finds the cycle with the smallest mean weight in a directed graph using Karp's
+// algorithm and returns its vertices along with its mean weight, which is the sum of its edge
+// weights divided by its number of edges. If the graph isn't weighted, each edge has a weight of
+// 1. Negative weights are supported.
+//
+// The cycle starts with its smallest vertex hash and is followed by the remaining vertices in the
+// order of the cycle's edges, without repeating the first vertex. A self-loop is a cycle that only
+// consists of its vertex. If the graph doesn't contain any cycle, ErrAcyclic will be returned.
+//
+// Karp's algorithm runs in O(|V|*|E|) time and requires O(|V|²) memory.
+func MinimumMeanCycle[K comparable, T any](g Graph[K, T]) ([]K, float64, error) {
+	if !g.Traits().IsDirected {
+		return nil, 0, errors.New("minimum mean cycles can only be found in directed graphs")
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get predecessor map: %w", err)
+	}
+
+	isWeighted := g.Traits().IsWeighted
+	vertices := sortedMapKeys(predecessorMap)
+	n := len(vertices)
+
+	// walks[k][v] is the smallest weight of a walk with exactly k edges ending at vertex v, where
+	// the walk may start at any vertex. predecessors[k][v] is the previous vertex on that walk.
+	walks := make([]map[K]int, n+1)
+	predecessors := make([]map[K]K, n+1)
+
+	walks[0] = make(map[K]int, n)
+	for _, vertex := range vertices {
+		walks[0][vertex] = 0
+	}
+
+	for k := 1; k <= n; k++ {
+		walks[k] = make(map[K]int, n)
+		predecessors[k] = make(map[K]K, n)
+
+		for _, vertex := range vertices {
+			for predecessor, edge := range predecessorMap[vertex] {
+				previous, ok := walks[k-1][predecessor]
+				if !ok {
+					continue
+				}
+
+				weight := 1
+				if isWeighted {
+					weight = edge.Properties.Weight
+				}
+
+				// Ties are broken in favor of the smallest predecessor to obtain a deterministic
+				// result.
+				current, ok := walks[k][vertex]
+				if !ok || previous+weight < current || previous+weight == current && keyLess(predecessor, predecessors[k][vertex]) {
+					walks[k][vertex] = previous + weight
+					predecessors[k][vertex] = predecessor
+				}
+			}
+		}
+	}
+
+	// For each vertex with a walk of n edges, the largest value of (walks[n][v]-walks[k][v])/(n-k)
+	// is determined. The minimum mean is the smallest of these values. The fractions are compared
+	// using cross-multiplication to avoid rounding errors.
+	found := false
+	var best K
+	var bestNumerator, bestDenominator int
+
+	for _, vertex := range vertices {
+		total, ok := walks[n][vertex]
+		if !ok {
+			continue
+		}
+
+		hasMaximum := false
+		var numerator, denominator int
+
+		for k := 0; k < n; k++ {
+			weight, ok := walks[k][vertex]
+			if !ok {
+				continue
+			}
+
+			if !hasMaximum || (total-weight)*denominator > numerator*(n-k) {
+				numerator, denominator = total-weight, n-k
+				hasMaximum = true
+			}
+		}
+
+		if !found || numerator*bestDenominator < bestNumerator*denominator {
+			best, bestNumerator, bestDenominator = vertex, numerator, denominator
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, 0, ErrAcyclic
+	}
+
+	// The walk of n edges ending at the best vertex contains a cycle, and each cycle on that walk
+	// has the minimum mean weight. Following the walk backwards until a vertex repeats yields one.
+	positions := make(map[K]int)
+	walk := make([]K, 0, n+1)
+
+	current := best
+	for k := n; ; k-- {
+		if position, ok := positions[current]; ok {
+			walk = walk[position:]
+			break
+		}
+
+		positions[current] = len(walk)
+		walk = append(walk, current)
+		current = predecessors[k][current]
+	}
+
+	// The walk has been collected backwards, so it is reversed to follow the edge directions. Then,
+	// the cycle is rotated to start with its smallest vertex.
+	cycle := make([]K, len(walk))
+	start := 0
+
+	for i := range walk {
+		cycle[i] = walk[len(walk)-1-i]
+		if keyLess(cycle[i], cycle[start]) {
+			start = i
+		}
+	}
+
+	cycle = append(cycle[start:], cycle[:start]...)
+
+	return cycle, float64(bestNumerator) / float64(bestDenominator), nil
+}
@@ -0,0 +1,222 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestMinimumMeanCycle(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		isWeighted    bool
+		vertices      []int
+		edges         []Edge[int]
+		expectedCycle []int
+		expectedMean  float64
+		shouldFail    bool
+	}{
+		"two cycles with different means": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 4}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 2}},
+				{Source: 4, Target: 5, Properties: EdgeProperties{Weight: 3}},
+				{Source: 5, Target: 3, Properties: EdgeProperties{Weight: 1}},
+			},
+			expectedCycle: []int{3, 4, 5},
+			expectedMean:  2,
+		},
+		"negative weights": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: -3}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: -1}},
+				{Source: 3, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			expectedCycle: []int{1, 2},
+			expectedMean:  -0.5,
+		},
+		"self-loop": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 2, Properties: EdgeProperties{Weight: -2}},
+			},
+			expectedCycle: []int{2},
+			expectedMean:  -2,
+		},
+		"unweighted graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 5}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedCycle: []int{1, 2, 3},
+			expectedMean:  1,
+		},
+		"acyclic graph": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			shouldFail: true,
+		},
+		"undirected graph": {
+			vertices:   []int{1, 2},
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+		if test.isWeighted {
+			options = append(options, Weighted())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		cycle, mean, err := MinimumMeanCycle(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !orderedSlicesAreEqual(cycle, test.expectedCycle) {
+			t.Errorf("%s: cycle expectancy doesn't match: expected %v, got %v", name, test.expectedCycle, cycle)
+		}
+
+		if mean != test.expectedMean {
+			t.Errorf("%s: mean expectancy doesn't match: expected %v, got %v", name, test.expectedMean, mean)
+		}
+	}
+}
+
+func TestMinimumMeanCycle_Acyclic(t *testing.T) {
+	graph := New(IntHash, Directed())
+	_ = graph.AddVertex(1)
+	_ = graph.AddVertex(2)
+	_ = graph.AddEdge(1, 2)
+
+	if _, _, err := MinimumMeanCycle(graph); !errors.Is(err, ErrAcyclic) {
+		t.Errorf("error expectancy doesn't match: expected %v, got %v", ErrAcyclic, err)
+	}
+}
+
+// TestMinimumMeanCycle_Random compares the results on random graphs with the minimum mean of all
+// simple cycles, which are enumerated exhaustively.
+func TestMinimumMeanCycle_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for round := 0; round < 50; round++ {
+		n := 6
+		graph := New(IntHash, Directed(), Weighted())
+
+		for i := 0; i < n; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		for i := 0; i < 2*n; i++ {
+			_ = graph.AddEdge(rng.Intn(n), rng.Intn(n), EdgeWeight(rng.Intn(21)-10))
+		}
+
+		expected, hasCycle := bruteForceMinimumMean(graph, n)
+
+		cycle, mean, err := MinimumMeanCycle(graph)
+
+		if hasCycle != (err == nil) {
+			t.Fatalf("cycle expectancy doesn't match: expected %v, got %v (error: %v)", hasCycle, err == nil, err)
+		}
+
+		if !hasCycle {
+			continue
+		}
+
+		if math.Abs(mean-expected) > 1e-9 {
+			t.Fatalf("mean expectancy doesn't match: expected %v, got %v", expected, mean)
+		}
+
+		total := 0
+		for i := range cycle {
+			edge, err := graph.Edge(cycle[i], cycle[(i+1)%len(cycle)])
+			if err != nil {
+				t.Fatalf("cycle %v contains non-existent edge (%d, %d)", cycle, cycle[i], cycle[(i+1)%len(cycle)])
+			}
+			total += edge.Properties.Weight
+		}
+
+		if math.Abs(float64(total)/float64(len(cycle))-mean) > 1e-9 {
+			t.Fatalf("mean of cycle %v doesn't match: expected %v, got %v", cycle, mean, float64(total)/float64(len(cycle)))
+		}
+	}
+}
+
+// bruteForceMinimumMean enumerates all simple cycles of a graph with vertices 0 to n-1 and
+// returns their smallest mean weight.
+func bruteForceMinimumMean(g Graph[int, int], n int) (float64, bool) {
+	adjacencyMap, _ := g.AdjacencyMap()
+
+	best := math.Inf(1)
+	onPath := make(map[int]bool)
+
+	var search func(start, current, weight, length int)
+	search = func(start, current, weight, length int) {
+		for adjacency, edge := range adjacencyMap[current] {
+			if adjacency == start {
+				if mean := float64(weight+edge.Properties.Weight) / float64(length+1); mean < best {
+					best = mean
+				}
+				continue
+			}
+			// Each cycle is only enumerated starting at its smallest vertex.
+			if adjacency < start || onPath[adjacency] {
+				continue
+			}
+			onPath[adjacency] = true
+			search(start, adjacency, weight+edge.Properties.Weight, length+1)
+			onPath[adjacency] = false
+		}
+	}
+
+	for start := 0; start < n; start++ {
+		onPath[start] = true
+		search(start, start, 0, 0)
+		onPath[start] = false
+	}
+
+	return best, !math.IsInf(best, 1)
+}
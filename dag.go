@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"container/heap"
 	"errors"
 	"fmt"
 )
@@ -55,6 +56,294 @@ func TopologicalSort[K comparable, T any](g Graph[K, T]) ([]K, error) {
 	return order, nil
 }
 
+// TopologicalSortWithPriority performs a topological sort like TopologicalSort, but lets the caller
+// control the order of vertices that don't depend on each other. Whenever multiple vertices are
+// available, i.e. all of their predecessors have been emitted, the vertex with the lowest priority
+// according to the given priority function is emitted first. Vertices with the same priority are
+// emitted in ascending order of their hashes.
+//
+// This is useful for scheduling dependent tasks, e.g. to run cheap tasks as early as possible.
+// Like TopologicalSort, TopologicalSortWithPriority only works for directed acyclic graphs.
+func TopologicalSortWithPriority[K comparable, T any](g Graph[K, T], priority func(K) int) ([]K, error) {
+	if !isDAG(g) {
+		return nil, errors.New("topological sort can only be performed on DAGs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	inDegrees := make(map[K]int, len(predecessorMap))
+	queue := &topologicalQueue[K]{}
+
+	for vertex, predecessors := range predecessorMap {
+		inDegrees[vertex] = len(predecessors)
+
+		if len(predecessors) == 0 {
+			heap.Push(queue, topologicalItem[K]{hash: vertex, priority: priority(vertex)})
+		}
+	}
+
+	order := make([]K, 0, len(predecessorMap))
+
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(topologicalItem[K]).hash
+		order = append(order, current)
+
+		for adjacency := range adjacencyMap[current] {
+			inDegrees[adjacency]--
+
+			if inDegrees[adjacency] == 0 {
+				heap.Push(queue, topologicalItem[K]{hash: adjacency, priority: priority(adjacency)})
+			}
+		}
+	}
+
+	return order, nil
+}
+
+// TopologicalSortSubset returns a topological order of the given target vertices and all vertices
+// they transitively depend on, i.e. all vertices from which a target can be reached. All other
+// vertices are omitted. This is what a build system needs in order to build specific targets.
+//
+// Unlike TopologicalSort, TopologicalSortSubset works for all directed graphs as long as there is
+// no cycle among the relevant vertices. If there is one, an error will be returned. Vertices that
+// don't depend on each other are emitted in ascending order of their hashes.
+func TopologicalSortSubset[K comparable, T any](g Graph[K, T], targets []K) ([]K, error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("topological sort can only be performed on directed graphs")
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	// Collect the targets and their ancestors by walking the edges backwards.
+	relevant := make(map[K]bool)
+	stack := make([]K, 0, len(targets))
+
+	for _, target := range targets {
+		if _, ok := predecessorMap[target]; !ok {
+			return nil, fmt.Errorf("could not find vertex with hash %v", target)
+		}
+		stack = append(stack, target)
+	}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if relevant[current] {
+			continue
+		}
+		relevant[current] = true
+
+		for predecessor := range predecessorMap[current] {
+			stack = append(stack, predecessor)
+		}
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	inDegrees := make(map[K]int, len(relevant))
+	queue := &topologicalQueue[K]{}
+
+	for vertex := range relevant {
+		inDegrees[vertex] = len(predecessorMap[vertex])
+		if inDegrees[vertex] == 0 {
+			heap.Push(queue, topologicalItem[K]{hash: vertex})
+		}
+	}
+
+	order := make([]K, 0, len(relevant))
+
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(topologicalItem[K]).hash
+		order = append(order, current)
+
+		for adjacency := range adjacencyMap[current] {
+			if !relevant[adjacency] {
+				continue
+			}
+
+			inDegrees[adjacency]--
+			if inDegrees[adjacency] == 0 {
+				heap.Push(queue, topologicalItem[K]{hash: adjacency})
+			}
+		}
+	}
+
+	if len(order) != len(relevant) {
+		return nil, errors.New("the relevant vertices contain a cycle")
+	}
+
+	return order, nil
+}
+
+// TopologicalNumbering determines whether the given directed graph is acyclic and returns the
+// position of each vertex in a topological order, numbered contiguously from 0 to n-1. Whether a
+// vertex u comes before a vertex v can then be tested by comparing their numbers. Vertices that
+// don't depend on each other are numbered in ascending order of their hashes.
+//
+// Unlike TopologicalSort, TopologicalNumbering works for all directed graphs, not only for graphs
+// with the Acyclic trait. If the graph contains a cycle, a *CycleError providing one of the cycles
+// will be returned, which wraps ErrEdgeCreatesCycle just like the error returned by AddEdge. The
+// cycle starts with the source and target vertex of one of its edges.
+func TopologicalNumbering[K comparable, T any](g Graph[K, T]) (map[K]int, error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("topological numbering can only be computed for directed graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	inDegrees := make(map[K]int, len(predecessorMap))
+	queue := &topologicalQueue[K]{}
+
+	for vertex, predecessors := range predecessorMap {
+		inDegrees[vertex] = len(predecessors)
+
+		if len(predecessors) == 0 {
+			heap.Push(queue, topologicalItem[K]{hash: vertex})
+		}
+	}
+
+	numbering := make(map[K]int, len(predecessorMap))
+
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(topologicalItem[K]).hash
+		numbering[current] = len(numbering)
+
+		for adjacency := range adjacencyMap[current] {
+			inDegrees[adjacency]--
+
+			if inDegrees[adjacency] == 0 {
+				heap.Push(queue, topologicalItem[K]{hash: adjacency})
+			}
+		}
+	}
+
+	if len(numbering) == len(predecessorMap) {
+		return numbering, nil
+	}
+
+	// Each remaining vertex has a remaining predecessor. Thus, walking the remaining predecessors
+	// backwards from any remaining vertex eventually revisits a vertex, which lies on a cycle.
+	var start K
+	for _, vertex := range sortedMapKeys(predecessorMap) {
+		if _, ok := numbering[vertex]; !ok {
+			start = vertex
+			break
+		}
+	}
+
+	visited := make(map[K]bool)
+	current := start
+
+	for !visited[current] {
+		visited[current] = true
+
+		for _, predecessor := range sortedMapKeys(predecessorMap[current]) {
+			if _, ok := numbering[predecessor]; !ok {
+				start, current = current, predecessor
+				break
+			}
+		}
+	}
+
+	// The walk has just moved from start to its predecessor, which has been visited before. Thus,
+	// both vertices lie on the cycle, and so does the edge from the predecessor to start.
+	return nil, newCycleError(g, current, start)
+}
+
+// WalkDependencies visits all vertices of a directed graph in dependency order and calls the visit
+// function with the hash and the value of each vertex. Edges point from a dependent vertex to its
+// dependency, so each vertex is visited only after all vertices it can reach, i.e. after all of its
+// direct and transitive dependencies. This is the order in which build targets need to be built.
+//
+// The vertices are visited in the reverse order of TopologicalNumbering. If the visit function
+// returns an error, the walk stops and that error is returned. If the graph contains a cycle, no
+// vertex is visited and the *CycleError returned by TopologicalNumbering is returned instead.
+func WalkDependencies[K comparable, T any](g Graph[K, T], visit func(K, T) error) error {
+	numbering, err := TopologicalNumbering(g)
+	if err != nil {
+		return err
+	}
+
+	order := make([]K, len(numbering))
+	for vertex, number := range numbering {
+		order[len(order)-1-number] = vertex
+	}
+
+	for _, hash := range order {
+		value, err := g.Vertex(hash)
+		if err != nil {
+			return fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+
+		if err := visit(hash, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// topologicalItem is a vertex that is available for being emitted in a topological order.
+type topologicalItem[K comparable] struct {
+	hash     K
+	priority int
+}
+
+// topologicalQueue is a min-heap of available vertices, ordered by their priority and their hash.
+// It implements heap.Interface.
+type topologicalQueue[K comparable] []topologicalItem[K]
+
+func (q topologicalQueue[K]) Len() int {
+	return len(q)
+}
+
+func (q topologicalQueue[K]) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority < q[j].priority
+	}
+
+	return keyLess(q[i].hash, q[j].hash)
+}
+
+func (q topologicalQueue[K]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *topologicalQueue[K]) Push(item any) {
+	*q = append(*q, item.(topologicalItem[K]))
+}
+
+func (q *topologicalQueue[K]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return item
+}
+
 // TransitiveReduction transforms the graph into its own transitive reduction. The transitive
 // reduction of the given graph is another graph with the same vertices and the same reachability,
 // but with as few edges as possible. This greatly reduces the complexity of the graph.
@@ -96,6 +385,134 @@ func TransitiveReduction[K comparable, T any](g Graph[K, T]) error {
 	return nil
 }
 
+// RedundantEdges returns the edges of the given graph that are implied by a longer path, i.e. the
+// edges (u, v) for which v can also be reached from u via some other vertex. These are exactly the
+// edges that TransitiveReduction would remove, but the graph itself is left untouched.
+//
+// RedundantEdges only works for directed acyclic graphs. Graphs containing cycles don't have a
+// unique transitive reduction, so an error is returned for graphs without the Acyclic trait. The
+// edges are returned in ascending order of their source and target hashes.
+func RedundantEdges[K comparable, T any](g Graph[K, T]) ([][2]K, error) {
+	if !isDAG(g) {
+		return nil, errors.New("redundant edges can only be determined for DAGs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	redundant := make([][2]K, 0)
+
+	for _, edge := range sortedEdges(adjacencyMap, true) {
+		source, target := edge.Source, edge.Target
+
+		// Search for the target starting from all other successors of the source. Since the graph
+		// is acyclic, any path found this way doesn't use the edge (source, target) itself.
+		visited := make(map[K]bool)
+		stack := make([]K, 0)
+
+		for successor := range adjacencyMap[source] {
+			if successor != target {
+				stack = append(stack, successor)
+			}
+		}
+
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if current == target {
+				redundant = append(redundant, [2]K{source, target})
+				break
+			}
+
+			if visited[current] {
+				continue
+			}
+			visited[current] = true
+
+			for adjacency := range adjacencyMap[current] {
+				stack = append(stack, adjacency)
+			}
+		}
+	}
+
+	return redundant, nil
+}
+
+// MinimumPathCover computes a minimum path cover of a directed acyclic graph, i.e. a minimum set of
+// vertex-disjoint paths such that each vertex lies on exactly one of them. A path may consist of a
+// single vertex. If each path is assigned to a machine that executes its vertices one after another,
+// the number of paths is the minimum number of machines required.
+//
+// The path cover is computed by a reduction to a maximum bipartite matching: Each vertex is split
+// into an outgoing and an incoming copy, and each edge (u, v) connects the outgoing copy of u with
+// the incoming copy of v. Each matched edge joins two paths into one, so the number of returned
+// paths equals the number of vertices minus the size of a maximum matching.
+//
+// MinimumPathCover only works for directed acyclic graphs. Each path is returned in the direction
+// of its edges, and the paths are sorted by the hash of their first vertex.
+func MinimumPathCover[K comparable, T any](g Graph[K, T]) ([][]K, error) {
+	if !isDAG(g) {
+		return nil, errors.New("path covers can only be computed for DAGs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	vertices := sortedMapKeys(adjacencyMap)
+
+	// next maps an outgoing copy to its matched incoming copy, and previous does the opposite.
+	next := make(map[K]K)
+	previous := make(map[K]K)
+
+	var augment func(source K, visited map[K]bool) bool
+
+	// augment searches an augmenting path starting at the outgoing copy of the given source vertex
+	// using Kuhn's algorithm. If there is one, the matching is extended along that path.
+	augment = func(source K, visited map[K]bool) bool {
+		for _, target := range sortedMapKeys(adjacencyMap[source]) {
+			if visited[target] {
+				continue
+			}
+			visited[target] = true
+
+			matched, ok := previous[target]
+			if !ok || augment(matched, visited) {
+				next[source] = target
+				previous[target] = source
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, vertex := range vertices {
+		augment(vertex, make(map[K]bool))
+	}
+
+	paths := make([][]K, 0, len(vertices)-len(next))
+
+	for _, vertex := range vertices {
+		if _, ok := previous[vertex]; ok {
+			continue
+		}
+
+		path := []K{vertex}
+		for current, ok := next[vertex]; ok; current, ok = next[current] {
+			path = append(path, current)
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
 func isDAG[K comparable, T any](g Graph[K, T]) bool {
 	return g.Traits().IsDirected && g.Traits().IsAcyclic
 }
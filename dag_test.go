@@ -1,6 +1,10 @@
 package graph
 
-import "testing"
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
 
 func TestDirectedTopologicalSort(t *testing.T) {
 	tests := map[string]struct {
@@ -79,6 +83,431 @@ func TestUndirectedTopologicalSort(t *testing.T) {
 	}
 }
 
+func TestTopologicalSortWithPriority(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		isAcyclic     bool
+		vertices      []string
+		edges         []Edge[string]
+		priority      map[string]int
+		expectedOrder []string
+		shouldFail    bool
+	}{
+		"cheap tasks first": {
+			isDirected: true,
+			isAcyclic:  true,
+			vertices:   []string{"build", "lint", "test", "deploy", "docs"},
+			edges: []Edge[string]{
+				{Source: "build", Target: "test"},
+				{Source: "lint", Target: "test"},
+				{Source: "test", Target: "deploy"},
+			},
+			priority: map[string]int{
+				"build":  5,
+				"lint":   1,
+				"test":   3,
+				"deploy": 0,
+				"docs":   4,
+			},
+			expectedOrder: []string{"lint", "docs", "build", "test", "deploy"},
+		},
+		"equal priorities in hash order": {
+			isDirected: true,
+			isAcyclic:  true,
+			vertices:   []string{"C", "B", "A", "D"},
+			edges: []Edge[string]{
+				{Source: "C", Target: "D"},
+			},
+			expectedOrder: []string{"A", "B", "C", "D"},
+		},
+		"dependency overrides priority": {
+			isDirected: true,
+			isAcyclic:  true,
+			vertices:   []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+			},
+			priority: map[string]int{
+				"A": 10,
+				"B": 0,
+			},
+			expectedOrder: []string{"A", "B"},
+		},
+		"graph that isn't acyclic": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			shouldFail: true,
+		},
+		"undirected graph": {
+			isAcyclic:  true,
+			vertices:   []string{"A", "B"},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		traits := []func(*Traits){}
+		if test.isDirected {
+			traits = append(traits, Directed())
+		}
+		if test.isAcyclic {
+			traits = append(traits, Acyclic())
+		}
+
+		graph := New(StringHash, traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		order, err := TopologicalSortWithPriority(graph, func(vertex string) int {
+			return test.priority[vertex]
+		})
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(order) != len(test.expectedOrder) {
+			t.Fatalf("%s: order length expectancy doesn't match: expected %v, got %v", name, len(test.expectedOrder), len(order))
+		}
+
+		for i, vertex := range test.expectedOrder {
+			if order[i] != vertex {
+				t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, test.expectedOrder, order)
+				break
+			}
+		}
+	}
+}
+
+func TestTopologicalSortSubset(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []string
+		edges         []Edge[string]
+		targets       []string
+		expectedOrder []string
+		shouldFail    bool
+	}{
+		"single target with dependencies": {
+			isDirected: true,
+			vertices:   []string{"lib", "util", "app", "docs", "test"},
+			edges: []Edge[string]{
+				{Source: "util", Target: "lib"},
+				{Source: "lib", Target: "app"},
+				{Source: "lib", Target: "test"},
+				{Source: "docs", Target: "test"},
+			},
+			targets:       []string{"app"},
+			expectedOrder: []string{"util", "lib", "app"},
+		},
+		"multiple targets": {
+			isDirected: true,
+			vertices:   []string{"lib", "util", "app", "docs", "test"},
+			edges: []Edge[string]{
+				{Source: "util", Target: "lib"},
+				{Source: "lib", Target: "app"},
+				{Source: "lib", Target: "test"},
+				{Source: "docs", Target: "test"},
+			},
+			targets:       []string{"test", "app"},
+			expectedOrder: []string{"docs", "util", "lib", "app", "test"},
+		},
+		"cycle outside of the relevant vertices": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "C", Target: "D"},
+				{Source: "D", Target: "C"},
+			},
+			targets:       []string{"B"},
+			expectedOrder: []string{"A", "B"},
+		},
+		"cycle among the relevant vertices": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "A"},
+				{Source: "B", Target: "C"},
+			},
+			targets:    []string{"C"},
+			shouldFail: true,
+		},
+		"no targets": {
+			isDirected:    true,
+			vertices:      []string{"A", "B"},
+			edges:         []Edge[string]{{Source: "A", Target: "B"}},
+			targets:       []string{},
+			expectedOrder: []string{},
+		},
+		"unknown target": {
+			isDirected: true,
+			vertices:   []string{"A"},
+			targets:    []string{"X"},
+			shouldFail: true,
+		},
+		"undirected graph": {
+			vertices:   []string{"A"},
+			targets:    []string{"A"},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[string, string]
+		if test.isDirected {
+			graph = New(StringHash, Directed())
+		} else {
+			graph = New(StringHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		order, err := TopologicalSortSubset(graph, test.targets)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !orderedSlicesAreEqual(order, test.expectedOrder) {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, test.expectedOrder, order)
+		}
+	}
+}
+
+func TestTopologicalNumbering(t *testing.T) {
+	tests := map[string]struct {
+		isDirected        bool
+		vertices          []int
+		edges             []Edge[int]
+		expectedNumbering map[int]int
+		expectedCycle     []int
+		shouldFail        bool
+	}{
+		"directed acyclic graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 5, Target: 4},
+			},
+			expectedNumbering: map[int]int{1: 0, 2: 1, 3: 2, 5: 3, 4: 4},
+		},
+		"graph without edges": {
+			isDirected:        true,
+			vertices:          []int{3, 1, 2},
+			expectedNumbering: map[int]int{1: 0, 2: 1, 3: 2},
+		},
+		"cycle behind an acyclic part": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 2},
+			},
+			expectedCycle: []int{2, 3, 4},
+			shouldFail:    true,
+		},
+		"self-loop": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 2},
+			},
+			expectedCycle: []int{2},
+			shouldFail:    true,
+		},
+		"undirected graph": {
+			vertices:   []int{1, 2},
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		numbering, err := TopologicalNumbering(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.expectedCycle != nil {
+			var cycleErr *CycleError[int]
+			if !errors.As(err, &cycleErr) {
+				t.Fatalf("%s: error expectancy doesn't match: expected a cycle error, got %v", name, err)
+			}
+
+			if !errors.Is(err, ErrEdgeCreatesCycle) {
+				t.Errorf("%s: error doesn't wrap %v", name, ErrEdgeCreatesCycle)
+			}
+
+			if !slicesAreEqual(cycleErr.Cycle(), test.expectedCycle) {
+				t.Errorf("%s: cycle expectancy doesn't match: expected %v, got %v", name, test.expectedCycle, cycleErr.Cycle())
+			}
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(numbering) != len(test.expectedNumbering) {
+			t.Fatalf("%s: numbering expectancy doesn't match: expected %v, got %v", name, test.expectedNumbering, numbering)
+		}
+
+		for vertex, expectedNumber := range test.expectedNumbering {
+			if number, ok := numbering[vertex]; !ok || number != expectedNumber {
+				t.Errorf("%s: number of vertex %v doesn't match: expected %v, got %v", name, vertex, expectedNumber, number)
+			}
+		}
+	}
+}
+
+func TestWalkDependencies(t *testing.T) {
+	errVisit := errors.New("visit failed")
+
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []string
+		edges         []Edge[string]
+		failAt        string
+		expectedOrder []string
+		expectedErr   error
+		shouldFail    bool
+	}{
+		"build targets": {
+			isDirected: true,
+			vertices:   []string{"app", "lib", "util", "docs"},
+			edges: []Edge[string]{
+				{Source: "app", Target: "lib"},
+				{Source: "lib", Target: "util"},
+				{Source: "app", Target: "util"},
+				{Source: "docs", Target: "app"},
+			},
+			expectedOrder: []string{"util", "lib", "app", "docs"},
+		},
+		"visit error stops the walk": {
+			isDirected: true,
+			vertices:   []string{"a", "b", "c"},
+			edges: []Edge[string]{
+				{Source: "a", Target: "b"},
+				{Source: "b", Target: "c"},
+			},
+			failAt:        "b",
+			expectedOrder: []string{"c", "b"},
+			expectedErr:   errVisit,
+			shouldFail:    true,
+		},
+		"cycle is reported before visiting": {
+			isDirected: true,
+			vertices:   []string{"a", "b", "c"},
+			edges: []Edge[string]{
+				{Source: "a", Target: "b"},
+				{Source: "b", Target: "a"},
+				{Source: "c", Target: "a"},
+			},
+			expectedOrder: []string{},
+			expectedErr:   ErrEdgeCreatesCycle,
+			shouldFail:    true,
+		},
+		"undirected graph": {
+			vertices:      []string{"a"},
+			expectedOrder: []string{},
+			shouldFail:    true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(StringHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		order := make([]string, 0)
+
+		err := WalkDependencies(graph, func(hash string, value string) error {
+			if hash != value {
+				t.Errorf("%s: value of vertex %v doesn't match: got %v", name, hash, value)
+			}
+			order = append(order, hash)
+			if hash == test.failAt {
+				return errVisit
+			}
+			return nil
+		})
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+			t.Errorf("%s: error expectancy doesn't match: expected %v, got %v", name, test.expectedErr, err)
+		}
+
+		if !orderedSlicesAreEqual(order, test.expectedOrder) {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, test.expectedOrder, order)
+		}
+	}
+}
+
 func TestDirectedTransitiveReduction(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []string
@@ -141,6 +570,271 @@ func TestDirectedTransitiveReduction(t *testing.T) {
 	}
 }
 
+func TestRedundantEdges(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		isAcyclic     bool
+		vertices      []string
+		edges         []Edge[string]
+		expectedEdges [][2]string
+		shouldFail    bool
+	}{
+		"graph as on img/transitive-reduction.svg": {
+			isDirected: true,
+			isAcyclic:  true,
+			vertices:   []string{"A", "B", "C", "D", "E"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "A", Target: "C"},
+				{Source: "A", Target: "D"},
+				{Source: "A", Target: "E"},
+				{Source: "B", Target: "D"},
+				{Source: "C", Target: "D"},
+				{Source: "C", Target: "E"},
+				{Source: "D", Target: "E"},
+			},
+			expectedEdges: [][2]string{{"A", "D"}, {"A", "E"}, {"C", "E"}},
+		},
+		"graph without redundant edges": {
+			isDirected: true,
+			isAcyclic:  true,
+			vertices:   []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "A", Target: "C"},
+			},
+			expectedEdges: [][2]string{},
+		},
+		"graph without acyclic trait": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "A"},
+			},
+			shouldFail: true,
+		},
+		"undirected graph": {
+			vertices:   []string{"A", "B"},
+			edges:      []Edge[string]{{Source: "A", Target: "B"}},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+		if test.isAcyclic {
+			options = append(options, Acyclic())
+		}
+
+		graph := New(StringHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		edges, err := RedundantEdges(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !orderedSlicesAreEqual(edges, test.expectedEdges) {
+			t.Errorf("%s: edge expectancy doesn't match: expected %v, got %v", name, test.expectedEdges, edges)
+		}
+
+		if size := graph.Size(); size != len(test.edges) {
+			t.Errorf("%s: graph has been modified: expected %d edges, got %d", name, len(test.edges), size)
+		}
+	}
+}
+
+func TestMinimumPathCover(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		isAcyclic     bool
+		vertices      []string
+		edges         []Edge[string]
+		expectedPaths [][]string
+		shouldFail    bool
+	}{
+		"diamond": {
+			isDirected: true,
+			isAcyclic:  true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "A", Target: "C"},
+				{Source: "B", Target: "D"},
+				{Source: "C", Target: "D"},
+			},
+			expectedPaths: [][]string{{"A", "B", "D"}, {"C"}},
+		},
+		"path with transitive edge": {
+			isDirected: true,
+			isAcyclic:  true,
+			vertices:   []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "A", Target: "C"},
+				{Source: "B", Target: "C"},
+			},
+			expectedPaths: [][]string{{"A", "B", "C"}},
+		},
+		"matching requiring an augmenting path": {
+			isDirected: true,
+			isAcyclic:  true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "C"},
+				{Source: "A", Target: "D"},
+				{Source: "B", Target: "C"},
+			},
+			expectedPaths: [][]string{{"A", "D"}, {"B", "C"}},
+		},
+		"graph without edges": {
+			isDirected:    true,
+			isAcyclic:     true,
+			vertices:      []string{"A", "B"},
+			expectedPaths: [][]string{{"A"}, {"B"}},
+		},
+		"empty graph": {
+			isDirected:    true,
+			isAcyclic:     true,
+			expectedPaths: [][]string{},
+		},
+		"graph without acyclic trait": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			edges:      []Edge[string]{{Source: "A", Target: "B"}},
+			shouldFail: true,
+		},
+		"undirected graph": {
+			vertices:   []string{"A", "B"},
+			edges:      []Edge[string]{{Source: "A", Target: "B"}},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+		if test.isAcyclic {
+			options = append(options, Acyclic())
+		}
+
+		graph := New(StringHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		paths, err := MinimumPathCover(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(paths) != len(test.expectedPaths) {
+			t.Fatalf("%s: path count expectancy doesn't match: expected %v, got %v", name, test.expectedPaths, paths)
+		}
+
+		for i, path := range paths {
+			if !orderedSlicesAreEqual(path, test.expectedPaths[i]) {
+				t.Errorf("%s: path expectancy doesn't match: expected %v, got %v", name, test.expectedPaths, paths)
+			}
+		}
+	}
+}
+
+// TestMinimumPathCover_Random checks that the path covers of random DAGs are valid and compares
+// their sizes with the maximum matchings computed by MaxWeightBipartiteMatching.
+func TestMinimumPathCover_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for round := 0; round < 50; round++ {
+		n := 1 + rng.Intn(12)
+
+		graph := New(IntHash, Directed(), Acyclic())
+		split := New(IntHash)
+
+		for i := 0; i < n; i++ {
+			_ = graph.AddVertex(i)
+			_ = split.AddVertex(i)
+			_ = split.AddVertex(n + i)
+		}
+
+		for i := 0; i < 2*n; i++ {
+			source, target := rng.Intn(n), rng.Intn(n)
+			if source >= target {
+				continue
+			}
+			_ = graph.AddEdge(source, target)
+			_ = split.AddEdge(source, n+target)
+		}
+
+		paths, err := MinimumPathCover(graph)
+		if err != nil {
+			t.Fatalf("round %d: unexpected error: %s", round, err.Error())
+		}
+
+		matching, _, err := MaxWeightBipartiteMatching(split)
+		if err != nil {
+			t.Fatalf("round %d: failed to compute matching: %s", round, err.Error())
+		}
+
+		if expected := n - len(matching)/2; len(paths) != expected {
+			t.Errorf("round %d: path count expectancy doesn't match: expected %v, got %v", round, expected, len(paths))
+		}
+
+		covered := make(map[int]bool)
+
+		for _, path := range paths {
+			for i, vertex := range path {
+				if covered[vertex] {
+					t.Fatalf("round %d: vertex %v is covered more than once: %v", round, vertex, paths)
+				}
+				covered[vertex] = true
+
+				if i > 0 {
+					if _, err := graph.Edge(path[i-1], vertex); err != nil {
+						t.Fatalf("round %d: path %v contains non-existent edge (%v, %v)", round, path, path[i-1], vertex)
+					}
+				}
+			}
+		}
+
+		if len(covered) != n {
+			t.Errorf("round %d: covered vertex count expectancy doesn't match: expected %v, got %v", round, n, len(covered))
+		}
+	}
+}
+
 func TestUndirectedTransitiveReduction(t *testing.T) {
 	tests := map[string]struct {
 		shouldFail bool
@@ -0,0 +1,221 @@
+package graph
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ImportDIMACS reads a graph in the DIMACS format from the given io.Reader. Two problem types are
+// supported, each of which is declared by the problem line starting with p:
+//
+//   - p edge N M declares an undirected graph with N vertices and M edges. Edges are given as
+//     e u v lines.
+//   - p sp N M declares a directed, weighted graph with N vertices and M edges. Edges are given
+//     as a u v w lines, where w is the edge weight.
+//
+// Many benchmark files for the edge problem type list each undirected edge in both directions,
+// i.e. as e u v and e v u. Since both lines describe the same edge, the reverse line is skipped,
+// but it still counts as an edge line.
+//
+// The returned graph contains the integer vertices 1 to N. Lines starting with c are treated as
+// comments. If the number of edge lines doesn't match the edge count declared in the problem line,
+// an error will be returned. Parse errors state the affected line number and the offending token.
+func ImportDIMACS(r io.Reader) (Graph[int, int], error) {
+	var (
+		g              Graph[int, int]
+		order          int
+		declaredEdges  int
+		edgeDescriptor string
+		edges          int
+		lineNumber     int
+		// edgeLines contains the source and target of each e line read so far.
+		edgeLines = make(map[[2]int]bool)
+	)
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		lineNumber++
+
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] == "c" {
+			continue
+		}
+
+		switch fields[0] {
+		case "p":
+			if g != nil {
+				return nil, fmt.Errorf("line %d: duplicate problem line", lineNumber)
+			}
+			if len(fields) != 4 {
+				return nil, fmt.Errorf("line %d: problem line must have 4 fields, got %d", lineNumber, len(fields))
+			}
+
+			switch fields[1] {
+			case "edge":
+				g = New(IntHash)
+				edgeDescriptor = "e"
+			case "sp":
+				g = New(IntHash, Directed(), Weighted())
+				edgeDescriptor = "a"
+			default:
+				return nil, fmt.Errorf("line %d: unsupported problem type %q", lineNumber, fields[1])
+			}
+
+			var err error
+
+			if order, err = parseDIMACSInt(fields[2], lineNumber); err != nil {
+				return nil, err
+			}
+			if declaredEdges, err = parseDIMACSInt(fields[3], lineNumber); err != nil {
+				return nil, err
+			}
+
+			for vertex := 1; vertex <= order; vertex++ {
+				_ = g.AddVertex(vertex)
+			}
+		case "e", "a":
+			if g == nil {
+				return nil, fmt.Errorf("line %d: edge %q appears before the problem line", lineNumber, fields[0])
+			}
+			if fields[0] != edgeDescriptor {
+				return nil, fmt.Errorf("line %d: unexpected edge descriptor %q, expected %q", lineNumber, fields[0], edgeDescriptor)
+			}
+
+			expectedFields := 3
+			if edgeDescriptor == "a" {
+				expectedFields = 4
+			}
+			if len(fields) != expectedFields {
+				return nil, fmt.Errorf("line %d: edge line must have %d fields, got %d", lineNumber, expectedFields, len(fields))
+			}
+
+			source, err := parseDIMACSInt(fields[1], lineNumber)
+			if err != nil {
+				return nil, err
+			}
+			target, err := parseDIMACSInt(fields[2], lineNumber)
+			if err != nil {
+				return nil, err
+			}
+
+			if edgeDescriptor == "e" {
+				if edgeLines[[2]int{target, source}] && !edgeLines[[2]int{source, target}] {
+					edgeLines[[2]int{source, target}] = true
+					edges++
+					continue
+				}
+				edgeLines[[2]int{source, target}] = true
+			}
+
+			var options []func(*EdgeProperties)
+
+			if edgeDescriptor == "a" {
+				weight, err := parseDIMACSInt(fields[3], lineNumber)
+				if err != nil {
+					return nil, err
+				}
+				options = append(options, EdgeWeight(weight))
+			}
+
+			if err := g.AddEdge(source, target, options...); err != nil {
+				return nil, fmt.Errorf("line %d: failed to add edge (%d, %d): %w", lineNumber, source, target, err)
+			}
+
+			edges++
+		default:
+			return nil, fmt.Errorf("line %d: unknown line descriptor %q", lineNumber, fields[0])
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	if g == nil {
+		return nil, errors.New("missing problem line")
+	}
+
+	if edges != declaredEdges {
+		return nil, fmt.Errorf("problem line declares %d edges, but %d edges were found", declaredEdges, edges)
+	}
+
+	return g, nil
+}
+
+// ExportDIMACS writes the given graph in the DIMACS format into an io.Writer. Undirected graphs are
+// written using the edge problem type and e u v edge lines, whereas directed graphs are written
+// using the sp problem type and a u v w edge lines including the edge weight. Since e lines don't
+// have a weight, the edge weights of undirected graphs are not exported. Each undirected edge is
+// written only once.
+//
+// DIMACS identifies vertices by the integers 1 to N. The vertex hashes are sorted in ascending
+// order, and the first vertex in that order is written as 1, the second as 2, and so on. Integer,
+// float, and string hashes are sorted by their natural order, all other hashes are sorted by their
+// string representation. Thus, exporting a graph created by ImportDIMACS yields the original file
+// without its comments.
+func ExportDIMACS[K comparable, T any](g Graph[K, T], w io.Writer) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sortKeys(vertices)
+
+	indices := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		indices[vertex] = i + 1
+	}
+
+	isDirected := g.Traits().IsDirected
+	buf := bufio.NewWriter(w)
+
+	if isDirected {
+		fmt.Fprintf(buf, "p sp %d %d\n", len(vertices), g.Size())
+	} else {
+		fmt.Fprintf(buf, "p edge %d %d\n", len(vertices), g.Size())
+	}
+
+	for _, vertex := range vertices {
+		targets := make([]K, 0, len(adjacencyMap[vertex]))
+
+		for target := range adjacencyMap[vertex] {
+			// Undirected edges are contained in the adjacency map twice, so only write them once.
+			if !isDirected && indices[target] < indices[vertex] {
+				continue
+			}
+			targets = append(targets, target)
+		}
+
+		sortKeys(targets)
+
+		for _, target := range targets {
+			if isDirected {
+				weight := adjacencyMap[vertex][target].Properties.Weight
+				fmt.Fprintf(buf, "a %d %d %d\n", indices[vertex], indices[target], weight)
+			} else {
+				fmt.Fprintf(buf, "e %d %d\n", indices[vertex], indices[target])
+			}
+		}
+	}
+
+	return buf.Flush()
+}
+
+func parseDIMACSInt(token string, lineNumber int) (int, error) {
+	value, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, fmt.Errorf("line %d: invalid integer %q", lineNumber, token)
+	}
+
+	return value, nil
+}
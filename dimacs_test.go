@@ -0,0 +1,132 @@
+package graph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestImportDIMACS(t *testing.T) {
+	tests := map[string]struct {
+		input         string
+		isDirected    bool
+		expectedOrder int
+		expectedEdges []Edge[int]
+		errorContains string
+	}{
+		"undirected graph": {
+			input:         "c example\np edge 4 3\ne 1 2\ne 2 3\ne 3 4\n",
+			expectedOrder: 4,
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+		},
+		"directed, weighted graph": {
+			input:         "p sp 3 2\na 1 2 5\na 2 3 7\n",
+			isDirected:    true,
+			expectedOrder: 3,
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 7}},
+			},
+		},
+		"undirected graph listing edges in both directions": {
+			input:         "p edge 3 4\ne 1 2\ne 2 1\ne 2 3\ne 3 2\n",
+			expectedOrder: 3,
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+		},
+		"reverse lines counted against the edge count": {
+			input:         "p edge 3 2\ne 1 2\ne 2 1\ne 2 3\ne 3 2\n",
+			errorContains: "declares 2 edges, but 4 edges were found",
+		},
+		"duplicate edge line": {
+			input:         "p edge 2 2\ne 1 2\ne 1 2\n",
+			errorContains: "line 3: failed to add edge (1, 2)",
+		},
+		"edge count mismatch": {
+			input:         "p edge 3 3\ne 1 2\ne 2 3\n",
+			errorContains: "declares 3 edges",
+		},
+		"invalid token": {
+			input:         "p edge 3 2\ne 1 2\ne 2 x\n",
+			errorContains: `line 3: invalid integer "x"`,
+		},
+		"missing problem line": {
+			input:         "c nothing here\n",
+			errorContains: "missing problem line",
+		},
+	}
+
+	for name, test := range tests {
+		g, err := ImportDIMACS(strings.NewReader(test.input))
+
+		if test.errorContains != "" {
+			if err == nil || !strings.Contains(err.Error(), test.errorContains) {
+				t.Errorf("%s: error expectancy doesn't match: expected error containing %q, got %v", name, test.errorContains, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if g.Traits().IsDirected != test.isDirected {
+			t.Errorf("%s: directedness expectancy doesn't match: expected %v, got %v", name, test.isDirected, g.Traits().IsDirected)
+		}
+
+		if g.Order() != test.expectedOrder {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, test.expectedOrder, g.Order())
+		}
+
+		if g.Size() != len(test.expectedEdges) {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), g.Size())
+		}
+
+		for _, expectedEdge := range test.expectedEdges {
+			edge, err := g.Edge(expectedEdge.Source, expectedEdge.Target)
+			if err != nil {
+				t.Errorf("%s: expected edge %v-%v doesn't exist", name, expectedEdge.Source, expectedEdge.Target)
+				continue
+			}
+			if edge.Properties.Weight != expectedEdge.Properties.Weight {
+				t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, expectedEdge.Properties.Weight, edge.Properties.Weight)
+			}
+		}
+	}
+}
+
+func TestExportDIMACS(t *testing.T) {
+	tests := map[string]struct {
+		input string
+	}{
+		"undirected graph": {
+			input: "p edge 4 3\ne 1 2\ne 1 4\ne 2 3\n",
+		},
+		"directed, weighted graph": {
+			input: "p sp 3 3\na 1 2 5\na 2 3 7\na 3 1 2\n",
+		},
+	}
+
+	for name, test := range tests {
+		g, err := ImportDIMACS(strings.NewReader(test.input))
+		if err != nil {
+			t.Fatalf("%s: failed to import graph: %s", name, err.Error())
+		}
+
+		buf := new(bytes.Buffer)
+
+		if err := ExportDIMACS(g, buf); err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if buf.String() != test.input {
+			t.Errorf("%s: output expectancy doesn't match: expected %q, got %q", name, test.input, buf.String())
+		}
+	}
+}
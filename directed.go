@@ -3,6 +3,7 @@ package graph
 import (
 	"errors"
 	"fmt"
+	"sort"
 )
 
 type directed[K comparable, T any] struct {
@@ -12,16 +13,26 @@ type directed[K comparable, T any] struct {
 	edges    map[K]map[K]Edge[T]
 	outEdges map[K]map[K]Edge[T]
 	inEdges  map[K]map[K]Edge[T]
+	// size is the number of edges, maintained by AddEdge and RemoveEdge so that Size is O(1).
+	size int
+	// insertion records the order in which vertices and edges have been added.
+	insertion *insertionSequence[K]
+	// order is a topological ordering of the vertices of an acyclic graph, mapping each vertex to
+	// its position. It is maintained incrementally by AddEdge and is nil if it isn't known yet.
+	order map[K]int
+	// nextOrder is the position assigned to the next vertex added to the ordering.
+	nextOrder int
 }
 
 func newDirected[K comparable, T any](hash Hash[K, T], traits *Traits) *directed[K, T] {
 	return &directed[K, T]{
-		hash:     hash,
-		traits:   traits,
-		vertices: make(map[K]T),
-		edges:    make(map[K]map[K]Edge[T]),
-		outEdges: make(map[K]map[K]Edge[T]),
-		inEdges:  make(map[K]map[K]Edge[T]),
+		hash:      hash,
+		traits:    traits,
+		vertices:  make(map[K]T),
+		edges:     make(map[K]map[K]Edge[T]),
+		outEdges:  make(map[K]map[K]Edge[T]),
+		inEdges:   make(map[K]map[K]Edge[T]),
+		insertion: newInsertionSequence[K](),
 	}
 }
 
@@ -31,7 +42,14 @@ func (d *directed[K, T]) Traits() *Traits {
 
 func (d *directed[K, T]) AddVertex(value T) error {
 	hash := d.hash(value)
+
+	if _, ok := d.vertices[hash]; !ok && d.order != nil {
+		d.order[hash] = d.nextOrder
+		d.nextOrder++
+	}
+
 	d.vertices[hash] = value
+	d.insertion.addVertex(hash)
 
 	return nil
 }
@@ -45,6 +63,11 @@ func (d *directed[K, T]) Vertex(hash K) (T, error) {
 	return vertex, nil
 }
 
+func (d *directed[K, T]) HasVertex(hash K) bool {
+	_, ok := d.vertices[hash]
+	return ok
+}
+
 func (d *directed[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error {
 	source, ok := d.vertices[sourceHash]
 	if !ok {
@@ -60,15 +83,18 @@ func (d *directed[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*Edge
 		return fmt.Errorf("an edge between vertices %v and %v already exists", sourceHash, targetHash)
 	}
 
-	// If the graph was declared to be acyclic, permit the creation of a cycle.
+	// If the graph was declared to be acyclic, permit the creation of a cycle. Otherwise, the
+	// topological ordering becomes invalid as soon as an edge is added.
 	if d.traits.IsAcyclic {
-		createsCycle, err := CreatesCycle[K, T](d, sourceHash, targetHash)
+		createsCycle, err := d.createsCycle(sourceHash, targetHash)
 		if err != nil {
 			return fmt.Errorf("failed to check for cycles: %w", err)
 		}
 		if createsCycle {
-			return fmt.Errorf("an edge between %v and %v would introduce a cycle", sourceHash, targetHash)
+			return newCycleError[K, T](d, sourceHash, targetHash)
 		}
+	} else {
+		d.order = nil
 	}
 
 	edge := Edge[T]{
@@ -84,17 +110,14 @@ func (d *directed[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*Edge
 	}
 
 	d.addEdge(sourceHash, targetHash, edge)
+	d.size++
+	d.insertion.addEdge(sourceHash, targetHash)
 
 	return nil
 }
 
 func (d *directed[K, T]) Edge(sourceHash, targetHash K) (Edge[T], error) {
-	sourceEdges, ok := d.edges[sourceHash]
-	if !ok {
-		return Edge[T]{}, ErrEdgeNotFound
-	}
-
-	edge, ok := sourceEdges[targetHash]
+	edge, ok := d.LookupEdge(sourceHash, targetHash)
 	if !ok {
 		return Edge[T]{}, ErrEdgeNotFound
 	}
@@ -102,6 +125,16 @@ func (d *directed[K, T]) Edge(sourceHash, targetHash K) (Edge[T], error) {
 	return edge, nil
 }
 
+func (d *directed[K, T]) LookupEdge(sourceHash, targetHash K) (Edge[T], bool) {
+	edge, ok := d.edges[sourceHash][targetHash]
+	return edge, ok
+}
+
+func (d *directed[K, T]) HasEdge(sourceHash, targetHash K) bool {
+	_, ok := d.edges[sourceHash][targetHash]
+	return ok
+}
+
 func (d *directed[K, T]) RemoveEdge(source, target K) error {
 	if _, err := d.Edge(source, target); err != nil {
 		return fmt.Errorf("failed to find edge from %v to %v: %w", source, target, err)
@@ -111,6 +144,20 @@ func (d *directed[K, T]) RemoveEdge(source, target K) error {
 	delete(d.inEdges[target], source)
 	delete(d.outEdges[source], target)
 
+	d.size--
+	d.insertion.removeEdge(source, target)
+
+	return nil
+}
+
+func (d *directed[K, T]) MapEdgeWeights(f func(source, target K, weight int) int) error {
+	for sourceHash, targets := range d.edges {
+		for targetHash, edge := range targets {
+			edge.Properties.Weight = f(sourceHash, targetHash, edge.Properties.Weight)
+			d.addEdge(sourceHash, targetHash, edge)
+		}
+	}
+
 	return nil
 }
 
@@ -162,6 +209,22 @@ func (d *directed[K, T]) PredecessorMap() (map[K]map[K]Edge[K], error) {
 	return predecessors, nil
 }
 
+func (d *directed[K, T]) PredecessorCount(hash K) (int, error) {
+	if _, ok := d.vertices[hash]; !ok {
+		return 0, fmt.Errorf("vertex with hash %v doesn't exist", hash)
+	}
+
+	return len(d.inEdges[hash]), nil
+}
+
+func (d *directed[K, T]) SuccessorCount(hash K) (int, error) {
+	if _, ok := d.vertices[hash]; !ok {
+		return 0, fmt.Errorf("vertex with hash %v doesn't exist", hash)
+	}
+
+	return len(d.outEdges[hash]), nil
+}
+
 func (d *directed[K, T]) Clone() (Graph[K, T], error) {
 	traits := &Traits{
 		IsDirected: d.traits.IsDirected,
@@ -176,13 +239,25 @@ func (d *directed[K, T]) Clone() (Graph[K, T], error) {
 		vertices[hash] = vertex
 	}
 
+	var order map[K]int
+	if d.order != nil {
+		order = make(map[K]int, len(d.order))
+		for hash, position := range d.order {
+			order[hash] = position
+		}
+	}
+
 	return &directed[K, T]{
-		hash:     d.hash,
-		traits:   traits,
-		vertices: vertices,
-		edges:    cloneEdges(d.edges),
-		outEdges: cloneEdges(d.outEdges),
-		inEdges:  cloneEdges(d.inEdges),
+		hash:      d.hash,
+		traits:    traits,
+		vertices:  vertices,
+		edges:     cloneEdges(d.edges),
+		outEdges:  cloneEdges(d.outEdges),
+		inEdges:   cloneEdges(d.inEdges),
+		size:      d.size,
+		insertion: d.insertion.clone(),
+		order:     order,
+		nextOrder: d.nextOrder,
 	}, nil
 }
 
@@ -191,11 +266,7 @@ func (d *directed[K, T]) Order() int {
 }
 
 func (d *directed[K, T]) Size() int {
-	size := 0
-	for _, outEdges := range d.outEdges {
-		size += len(outEdges)
-	}
-	return size
+	return d.size
 }
 
 func (d *directed[K, T]) edgesAreEqual(a, b Edge[T]) bool {
@@ -227,6 +298,129 @@ func (d *directed[K, T]) addEdge(sourceHash, targetHash K, edge Edge[T]) {
 	d.inEdges[targetHash][sourceHash] = edge
 }
 
+// createsCycle determines whether an edge from source to target would create a cycle and, if it
+// doesn't, updates the topological ordering so that it remains valid once the edge is added. This
+// is the online topological ordering algorithm by Pearce and Kelly: Only the vertices between the
+// target and the source in the current ordering are visited and reordered, making the check cheap
+// for most insertions.
+//
+// If there is no ordering yet, it is computed from scratch first. If that is impossible because
+// the graph already has a cycle, e.g. because the traits have been changed after adding edges,
+// createsCycle falls back to CreatesCycle.
+func (d *directed[K, T]) createsCycle(source, target K) (bool, error) {
+	if source == target {
+		return true, nil
+	}
+
+	if d.order == nil && !d.computeOrder() {
+		return CreatesCycle[K, T](d, source, target)
+	}
+
+	lowerBound, upperBound := d.order[target], d.order[source]
+
+	// If the target already comes after the source, the edge complies with the ordering.
+	if lowerBound > upperBound {
+		return false, nil
+	}
+
+	// Search for the source vertex among the vertices reachable from the target that don't come
+	// after the source. Finding it means that the edge would close a cycle.
+	forward := []K{target}
+	visited := map[K]bool{target: true}
+
+	for i := 0; i < len(forward); i++ {
+		for adjacency := range d.outEdges[forward[i]] {
+			if adjacency == source {
+				return true, nil
+			}
+			if visited[adjacency] || d.order[adjacency] > upperBound {
+				continue
+			}
+			visited[adjacency] = true
+			forward = append(forward, adjacency)
+		}
+	}
+
+	// Collect the vertices the source is reachable from that don't come before the target.
+	backward := []K{source}
+	visited[source] = true
+
+	for i := 0; i < len(backward); i++ {
+		for predecessor := range d.inEdges[backward[i]] {
+			if visited[predecessor] || d.order[predecessor] < lowerBound {
+				continue
+			}
+			visited[predecessor] = true
+			backward = append(backward, predecessor)
+		}
+	}
+
+	// Both sets are placed in their current relative order, but all vertices reaching the source
+	// are moved in front of the vertices reachable from the target. They reuse the positions that
+	// both sets have occupied so far.
+	byOrder := func(vertices []K) {
+		sort.Slice(vertices, func(i, j int) bool {
+			return d.order[vertices[i]] < d.order[vertices[j]]
+		})
+	}
+
+	byOrder(forward)
+	byOrder(backward)
+
+	vertices := append(backward, forward...)
+	positions := make([]int, 0, len(vertices))
+
+	for _, vertex := range vertices {
+		positions = append(positions, d.order[vertex])
+	}
+
+	sort.Ints(positions)
+
+	for i, vertex := range vertices {
+		d.order[vertex] = positions[i]
+	}
+
+	return false, nil
+}
+
+// computeOrder computes a topological ordering of all vertices using Kahn's algorithm. If the graph
+// has a cycle, no ordering exists and false will be returned.
+func (d *directed[K, T]) computeOrder() bool {
+	order := make(map[K]int, len(d.vertices))
+	inDegrees := make(map[K]int, len(d.vertices))
+	queue := make([]K, 0)
+
+	for vertex := range d.vertices {
+		inDegrees[vertex] = len(d.inEdges[vertex])
+		if inDegrees[vertex] == 0 {
+			queue = append(queue, vertex)
+		}
+	}
+
+	for len(queue) > 0 {
+		vertex := queue[0]
+		queue = queue[1:]
+
+		order[vertex] = len(order)
+
+		for adjacency := range d.outEdges[vertex] {
+			inDegrees[adjacency]--
+			if inDegrees[adjacency] == 0 {
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	if len(order) < len(d.vertices) {
+		return false
+	}
+
+	d.order = order
+	d.nextOrder = len(order)
+
+	return true
+}
+
 func (d *directed[K, T]) predecessors(vertexHash K) []K {
 	var predecessorHashes []K
 
@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"errors"
+	"math/rand"
 	"testing"
 )
 
@@ -195,6 +197,92 @@ func TestDirected_AddEdge(t *testing.T) {
 	}
 }
 
+// TestDirected_AddEdgeAcyclicRandom adds random edges to an acyclic graph and compares the outcome
+// with CreatesCycle. It also checks that the maintained topological ordering stays valid.
+func TestDirected_AddEdgeAcyclicRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for round := 0; round < 20; round++ {
+		n := 30
+		graph := newDirected(IntHash, &Traits{IsDirected: true, IsAcyclic: true})
+
+		for i := 0; i < n; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		for i := 0; i < 200; i++ {
+			// Vertices are also added after the ordering has been established.
+			if i == 100 {
+				for j := n; j < 2*n; j++ {
+					_ = graph.AddVertex(j)
+				}
+				n *= 2
+			}
+
+			source, target := rng.Intn(n), rng.Intn(n)
+
+			if graph.HasEdge(source, target) {
+				continue
+			}
+
+			createsCycle, err := CreatesCycle[int, int](graph, source, target)
+			if err != nil {
+				t.Fatalf("failed to check for cycles: %s", err.Error())
+			}
+
+			err = graph.AddEdge(source, target)
+
+			if createsCycle != (err != nil) {
+				t.Fatalf("(%d, %d): error expectancy doesn't match: expected %v, got %v (error: %v)", source, target, createsCycle, err != nil, err)
+			}
+
+			if createsCycle && !errors.Is(err, ErrEdgeCreatesCycle) {
+				t.Fatalf("(%d, %d): error expectancy doesn't match: expected %v, got %v", source, target, ErrEdgeCreatesCycle, err)
+			}
+
+			for edgeSource, targets := range graph.outEdges {
+				for edgeTarget := range targets {
+					if graph.order[edgeSource] >= graph.order[edgeTarget] {
+						t.Fatalf("ordering is invalid: %d at %d comes before %d at %d", edgeTarget, graph.order[edgeTarget], edgeSource, graph.order[edgeSource])
+					}
+				}
+			}
+		}
+	}
+}
+
+// BenchmarkDirected_AddEdgeAcyclic inserts 100,000 edges into an acyclic graph with 10,000
+// vertices. The vertices are added in random order, so that many insertions require the
+// topological ordering to be updated.
+func BenchmarkDirected_AddEdgeAcyclic(b *testing.B) {
+	const order, size = 10000, 100000
+
+	rng := rand.New(rand.NewSource(42))
+	vertices := rng.Perm(order)
+
+	edges := make([][2]int, 0, size)
+	for len(edges) < size {
+		source, target := rng.Intn(order), rng.Intn(order)
+		if source < target {
+			edges = append(edges, [2]int{source, target})
+		}
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		graph := New(IntHash, Directed(), Acyclic())
+
+		for _, vertex := range vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range edges {
+			_ = graph.AddEdge(edge[0], edge[1])
+		}
+	}
+}
+
 func TestDirected_Edge(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []int
@@ -231,6 +319,174 @@ func TestDirected_Edge(t *testing.T) {
 	}
 }
 
+func TestDirected_HasVertex(t *testing.T) {
+	tests := map[string]struct {
+		vertices []int
+		hash     int
+		expected bool
+	}{
+		"existing vertex": {
+			vertices: []int{1, 2},
+			hash:     2,
+			expected: true,
+		},
+		"missing vertex": {
+			vertices: []int{1, 2},
+			hash:     3,
+			expected: false,
+		},
+		"empty graph": {
+			hash:     1,
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash, Directed())
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		if hasVertex := graph.HasVertex(test.hash); hasVertex != test.expected {
+			t.Errorf("%s: vertex expectancy doesn't match: expected %v, got %v", name, test.expected, hasVertex)
+		}
+	}
+}
+
+func TestDirected_HasEdge(t *testing.T) {
+	tests := map[string]struct {
+		vertices []int
+		edges    []Edge[int]
+		source   int
+		target   int
+		expected bool
+	}{
+		"existing edge": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			source:   1,
+			target:   2,
+			expected: true,
+		},
+		"reversed edge": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			source:   2,
+			target:   1,
+			expected: false,
+		},
+		"missing edge": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			source:   1,
+			target:   3,
+			expected: false,
+		},
+		"missing vertex": {
+			vertices: []int{1},
+			source:   1,
+			target:   5,
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash, Directed())
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		if hasEdge := graph.HasEdge(test.source, test.target); hasEdge != test.expected {
+			t.Errorf("%s: edge expectancy doesn't match: expected %v, got %v", name, test.expected, hasEdge)
+		}
+	}
+}
+
+func TestDirected_LookupEdge(t *testing.T) {
+	tests := map[string]struct {
+		vertices       []int
+		edges          []Edge[int]
+		source         int
+		target         int
+		expected       bool
+		expectedWeight int
+	}{
+		"existing edge": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+			},
+			source:         1,
+			target:         2,
+			expected:       true,
+			expectedWeight: 4,
+		},
+		"reversed edge": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+			},
+			source:         2,
+			target:         1,
+			expected:       false,
+			expectedWeight: 0,
+		},
+		"missing edge": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			source:   1,
+			target:   3,
+			expected: false,
+		},
+		"missing vertex": {
+			vertices: []int{1},
+			source:   1,
+			target:   5,
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash, Directed(), Weighted())
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		edge, ok := graph.LookupEdge(test.source, test.target)
+
+		if ok != test.expected {
+			t.Errorf("%s: edge expectancy doesn't match: expected %v, got %v", name, test.expected, ok)
+		}
+
+		if edge.Properties.Weight != test.expectedWeight {
+			t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, test.expectedWeight, edge.Properties.Weight)
+		}
+	}
+}
+
 func TestDirected_RemoveEdge(t *testing.T) {
 	tests := map[string]struct {
 		vertices    []int
@@ -285,6 +541,108 @@ func TestDirected_RemoveEdge(t *testing.T) {
 	}
 }
 
+func TestDirected_MapEdgeWeights(t *testing.T) {
+	tests := map[string]struct {
+		vertices        []int
+		edges           []Edge[int]
+		f               func(source, target, weight int) int
+		expectedWeights []Edge[int]
+		expectedCalls   int
+	}{
+		"double all weights": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 5}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: -1}},
+			},
+			f: func(_, _, weight int) int {
+				return weight * 2
+			},
+			expectedWeights: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 6}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 10}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: -2}},
+			},
+			expectedCalls: 3,
+		},
+		"weights depending on vertices": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 2},
+				{Source: 3, Target: 3},
+			},
+			f: func(source, target, _ int) int {
+				return source*10 + target
+			},
+			expectedWeights: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 12}},
+				{Source: 3, Target: 2, Properties: EdgeProperties{Weight: 32}},
+				{Source: 3, Target: 3, Properties: EdgeProperties{Weight: 33}},
+			},
+			expectedCalls: 3,
+		},
+		"graph without edges": {
+			vertices: []int{1, 2},
+			f: func(_, _, weight int) int {
+				return weight + 1
+			},
+			expectedCalls: 0,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash, Directed())
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		calls := 0
+
+		err := graph.MapEdgeWeights(func(source, target, weight int) int {
+			calls++
+			return test.f(source, target, weight)
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if calls != test.expectedCalls {
+			t.Errorf("%s: call count expectancy doesn't match: expected %v, got %v", name, test.expectedCalls, calls)
+		}
+
+		adjacencyMap, _ := graph.AdjacencyMap()
+		predecessorMap, _ := graph.PredecessorMap()
+
+		for _, expected := range test.expectedWeights {
+			edge, err := graph.Edge(expected.Source, expected.Target)
+			if err != nil {
+				t.Fatalf("%s: failed to get edge: %s", name, err.Error())
+			}
+
+			if edge.Properties.Weight != expected.Properties.Weight {
+				t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, expected.Properties.Weight, edge.Properties.Weight)
+			}
+
+			if weight := adjacencyMap[expected.Source][expected.Target].Properties.Weight; weight != expected.Properties.Weight {
+				t.Errorf("%s: adjacency map weight expectancy doesn't match: expected %v, got %v", name, expected.Properties.Weight, weight)
+			}
+
+			if weight := predecessorMap[expected.Target][expected.Source].Properties.Weight; weight != expected.Properties.Weight {
+				t.Errorf("%s: predecessor map weight expectancy doesn't match: expected %v, got %v", name, expected.Properties.Weight, weight)
+			}
+		}
+	}
+}
+
 func TestDirected_AdjacencyList(t *testing.T) {
 	tests := map[string]struct {
 		vertices []int
@@ -452,6 +810,82 @@ func TestDirected_PredecessorMap(t *testing.T) {
 	}
 }
 
+func TestDirected_PredecessorAndSuccessorCount(t *testing.T) {
+	tests := map[string]struct {
+		vertices                 []int
+		edges                    []Edge[int]
+		vertex                   int
+		expectedPredecessorCount int
+		expectedSuccessorCount   int
+		shouldFail               bool
+	}{
+		"vertex with predecessors and successors": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 2},
+				{Source: 2, Target: 4},
+			},
+			vertex:                   2,
+			expectedPredecessorCount: 2,
+			expectedSuccessorCount:   1,
+		},
+		"vertex without edges": {
+			vertices:                 []int{1, 2},
+			edges:                    []Edge[int]{{Source: 1, Target: 1}},
+			vertex:                   2,
+			expectedPredecessorCount: 0,
+			expectedSuccessorCount:   0,
+		},
+		"vertex with self-loop": {
+			vertices:                 []int{1, 2},
+			edges:                    []Edge[int]{{Source: 1, Target: 1}, {Source: 1, Target: 2}},
+			vertex:                   1,
+			expectedPredecessorCount: 1,
+			expectedSuccessorCount:   2,
+		},
+		"non-existent vertex": {
+			vertices:   []int{1},
+			vertex:     2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph := newDirected(IntHash, &Traits{})
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		predecessorCount, err := graph.PredecessorCount(test.vertex)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		successorCount, err := graph.SuccessorCount(test.vertex)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if predecessorCount != test.expectedPredecessorCount {
+			t.Errorf("%s: predecessor count expectancy doesn't match: expected %d, got %d", name, test.expectedPredecessorCount, predecessorCount)
+		}
+
+		if successorCount != test.expectedSuccessorCount {
+			t.Errorf("%s: successor count expectancy doesn't match: expected %d, got %d", name, test.expectedSuccessorCount, successorCount)
+		}
+	}
+}
+
 func TestDirected_Clone(t *testing.T) {
 	tests := map[string]struct {
 		vertices []int
@@ -535,6 +969,7 @@ func TestDirected_OrderAndSize(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []int
 		edges         []Edge[int]
+		removedEdges  []Edge[int]
 		expectedOrder int
 		expectedSize  int
 	}{
@@ -574,6 +1009,29 @@ func TestDirected_OrderAndSize(t *testing.T) {
 			expectedOrder: 2,
 			expectedSize:  0,
 		},
+		"graph with self-loop": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			expectedOrder: 2,
+			expectedSize:  2,
+		},
+		"graph with removed edges": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 3},
+			},
+			removedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 3},
+			},
+			expectedOrder: 3,
+			expectedSize:  1,
+		},
 	}
 
 	for name, test := range tests {
@@ -589,6 +1047,12 @@ func TestDirected_OrderAndSize(t *testing.T) {
 			}
 		}
 
+		for _, edge := range test.removedEdges {
+			if err := graph.RemoveEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to remove edge: %s", name, err.Error())
+			}
+		}
+
 		order := graph.Order()
 		size := graph.Size()
 
@@ -600,6 +1064,11 @@ func TestDirected_OrderAndSize(t *testing.T) {
 			t.Errorf("%s: size expectancy doesn't match: expected %d, got %d", name, test.expectedSize, size)
 		}
 
+		clone, _ := graph.Clone()
+
+		if clone.Size() != test.expectedSize {
+			t.Errorf("%s: clone size expectancy doesn't match: expected %d, got %d", name, test.expectedSize, clone.Size())
+		}
 	}
 }
 
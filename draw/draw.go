@@ -5,31 +5,115 @@ package draw
 import (
 	"fmt"
 	"io"
+	"reflect"
+	"sort"
 	"text/template"
 
 	"github.com/dominikbraun/graph"
 )
 
 const dotTemplate = `strict {{.GraphType}} {
-{{range $s := .Statements}}
-	{{.Source}} {{if .Target}}{{$.EdgeOperator}} {{.Target}} [ {{range $k, $v := .Attributes}}{{$k}}="{{$v}}", {{end}} weight={{.Weight}} ]{{end}};
+` + dotDefaultsTemplate + dotNodesTemplate + `{{range $s := .Statements}}
+	{{.Source}} {{if .Target}}{{$.EdgeOperator}} {{.Target}}` + dotEdgeAttributesTemplate + `{{end}};
 {{end}}
-}
+` + dotRanksTemplate + `}
 `
 
+// dotEdgeAttributesTemplate renders the attribute list of an edge statement. The weight is only
+// rendered for weighted graphs, and the list is omitted entirely if it would be empty.
+const dotEdgeAttributesTemplate = `{{if or .Attributes .Weighted}} [ {{range $k, $v := .Attributes}}{{$k}}="{{$v}}", {{end}}{{if .Weighted}} weight={{.Weight}}{{end}} ]{{end}}`
+
+// dotDefaultsTemplate renders the default attributes for all nodes and edges, if there are any.
+const dotDefaultsTemplate = `{{if .NodeAttributes}}
+	node [ {{range $k, $v := .NodeAttributes}}{{$k}}="{{$v}}", {{end}}];
+{{end}}{{if .EdgeAttributes}}
+	edge [ {{range $k, $v := .EdgeAttributes}}{{$k}}="{{$v}}", {{end}}];
+{{end}}`
+
+// dotNodesTemplate renders the attributes of individual nodes, if there are any.
+const dotNodesTemplate = `{{range .Nodes}}
+	{{.ID}} [ {{range $k, $v := .Attributes}}{{$k}}="{{$v}}", {{end}}];
+{{end}}`
+
+// dotRanksTemplate renders a subgraph for each rank that places its vertices on the same level.
+const dotRanksTemplate = `{{range .Ranks}}
+	{ rank=same; {{range .}}{{.}}; {{end}}}
+{{end}}`
+
+const (
+	dotStreamHeaderTemplate    = "strict {{.GraphType}} {\n" + dotDefaultsTemplate + dotNodesTemplate
+	dotStreamStatementTemplate = `
+	{{.Source}} {{if .Target}}{{.EdgeOperator}} {{.Target}}` + dotEdgeAttributesTemplate + `{{end}};
+`
+	dotStreamFooterTemplate = "\n" + dotRanksTemplate + "}\n"
+)
+
 type description struct {
-	GraphType    string
-	EdgeOperator string
-	Statements   []statement
+	GraphType      string
+	EdgeOperator   string
+	NodeAttributes map[string]string
+	EdgeAttributes map[string]string
+	Nodes          []node
+	Statements     []statement
+	Ranks          [][]interface{}
+	rankRoot       interface{}
+	groupBy        interface{}
+}
+
+// node holds the attributes of an individual vertex, which take precedence over the default node
+// attributes.
+type node struct {
+	ID         interface{}
+	Attributes map[string]string
 }
 
 type statement struct {
 	Source     interface{}
 	Target     interface{}
 	Weight     int
+	Weighted   bool
 	Attributes map[string]string
 }
 
+// streamedStatement is a statement that is rendered on its own by DOTStream. Because it is not
+// embedded in a description, it has to carry the edge operator by itself.
+type streamedStatement struct {
+	statement
+	EdgeOperator string
+}
+
+// DefaultNodeAttributes sets attributes that apply to all nodes of the rendered graph, such as a
+// common shape. They are emitted as a node statement at the top of the DOT output, and attributes
+// set on an individual node take precedence over them.
+//
+//	_ = draw.DOT(g, file, draw.DefaultNodeAttributes(map[string]string{"shape": "box"}))
+func DefaultNodeAttributes(attributes map[string]string) func(*description) {
+	return func(d *description) {
+		d.NodeAttributes = attributes
+	}
+}
+
+// DefaultEdgeAttributes sets attributes that apply to all edges of the rendered graph, such as a
+// common style. They are emitted as an edge statement at the top of the DOT output, and attributes
+// set on an individual edge take precedence over them.
+func DefaultEdgeAttributes(attributes map[string]string) func(*description) {
+	return func(d *description) {
+		d.EdgeAttributes = attributes
+	}
+}
+
+// RankByDepth places all vertices with the same breadth-first search depth from the given root
+// vertex on the same level by emitting a rank=same subgraph for each depth. This results in clean
+// layered layouts for trees and DAGs. Vertices that aren't reachable from the root are placed on
+// a trailing level of their own. The root vertex must have the same hash type as the graph.
+//
+//	_ = draw.DOT(g, file, draw.RankByDepth("root"))
+func RankByDepth[K comparable](root K) func(*description) {
+	return func(d *description) {
+		d.rankRoot = root
+	}
+}
+
 // DOT renders the given graph structure in DOT language into an io.Writer, for example a file. The
 // generated output can be passed to Graphviz or other visualization tools supporting DOT.
 //
@@ -55,15 +139,194 @@ type statement struct {
 // pipe it as follows:
 //
 //	go run main.go | dot -Tsvg > output.svg
-func DOT[K comparable, T any](g graph.Graph[K, T], w io.Writer) error {
+//
+// Edge weights are rendered as the weight attribute of each edge statement if the graph has the
+// Weighted trait. For unweighted graphs, the weight is omitted.
+//
+// A self-loop is rendered as an edge statement from a vertex to itself, such as a -> a, and Graphviz
+// draws it as a loop next to the vertex. Its attributes are rendered like those of any other edge,
+// so its appearance can be controlled using edge attributes such as "label" or "headport".
+//
+// Edge attributes are rendered as attributes of the corresponding edge statement. This includes
+// the "dir" attribute, which allows to mix directed and undirected edges: An edge with dir=none is
+// rendered without an arrowhead in a directed graph, and an edge with dir=forward is rendered with
+// an arrowhead in an undirected graph, pointing to the target vertex the edge has been added with.
+//
+// DOT accepts functional options such as DefaultNodeAttributes to customize the output.
+func DOT[K comparable, T any](g graph.Graph[K, T], w io.Writer, options ...func(*description)) error {
 	desc, err := generateDOT(g)
 	if err != nil {
 		return fmt.Errorf("failed to generate DOT description: %w", err)
 	}
 
+	for _, option := range options {
+		option(&desc)
+	}
+
+	if err := rankVertices(g, &desc); err != nil {
+		return err
+	}
+
+	if err := colorGroups(g, &desc); err != nil {
+		return err
+	}
+
 	return renderDOT(w, desc)
 }
 
+// DOTPath renders only the vertices and edges along the given path in DOT language into an
+// io.Writer. The path is a sequence of vertex hashes as returned by graph.ShortestPath, for
+// example, and each pair of consecutive vertices has to be joined by an edge in the graph. The
+// weights and attributes of the rendered edges are taken from the graph.
+//
+// This is useful for visualizing the result of a path computation without building a graph for
+// it first. If a vertex of the path doesn't exist in the graph, or if two consecutive vertices
+// aren't joined by an edge, an error will be returned.
+func DOTPath[K comparable, T any](g graph.Graph[K, T], path []K, w io.Writer) error {
+	desc := description{
+		GraphType:    "graph",
+		EdgeOperator: "--",
+		Statements:   make([]statement, 0),
+	}
+
+	if g.Traits().IsDirected {
+		desc.GraphType = "digraph"
+		desc.EdgeOperator = "->"
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for _, vertex := range path {
+		if _, ok := adjacencyMap[vertex]; !ok {
+			return fmt.Errorf("could not find path vertex with hash %v", vertex)
+		}
+	}
+
+	if len(path) == 1 {
+		desc.Statements = append(desc.Statements, statement{Source: path[0]})
+	}
+
+	for i := 1; i < len(path); i++ {
+		source, target := path[i-1], path[i]
+
+		edge, ok := adjacencyMap[source][target]
+		if !ok {
+			return fmt.Errorf("path vertices %v and %v are not joined by an edge", source, target)
+		}
+
+		desc.Statements = append(desc.Statements, statement{
+			Source:     source,
+			Target:     target,
+			Weight:     edge.Properties.Weight,
+			Weighted:   g.Traits().IsWeighted,
+			Attributes: edge.Properties.Attributes,
+		})
+	}
+
+	return renderDOT(w, desc)
+}
+
+// DOTStream renders the given graph structure in DOT language into an io.Writer just like DOT does,
+// and produces the same output. However, it doesn't build a description of the entire graph in
+// memory first. Instead, DOTStream writes the graph header, then writes one statement at a time
+// while iterating over the graph's adjacency map, and finally writes the footer.
+//
+// This keeps the memory consumption bounded, making DOTStream suitable for exporting very large
+// graphs with millions of edges. DOTStream accepts the same options as DOT.
+func DOTStream[K comparable, T any](g graph.Graph[K, T], w io.Writer, options ...func(*description)) error {
+	headerTpl, err := template.New("dotStreamHeaderTemplate").Parse(dotStreamHeaderTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse header template: %w", err)
+	}
+
+	statementTpl, err := template.New("dotStreamStatementTemplate").Parse(dotStreamStatementTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse statement template: %w", err)
+	}
+
+	footerTpl, err := template.New("dotStreamFooterTemplate").Parse(dotStreamFooterTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse footer template: %w", err)
+	}
+
+	desc := description{
+		GraphType:    "graph",
+		EdgeOperator: "--",
+	}
+
+	if g.Traits().IsDirected {
+		desc.GraphType = "digraph"
+		desc.EdgeOperator = "->"
+	}
+
+	for _, option := range options {
+		option(&desc)
+	}
+
+	if err := rankVertices(g, &desc); err != nil {
+		return err
+	}
+
+	if err := colorGroups(g, &desc); err != nil {
+		return err
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	if err := headerTpl.Execute(w, desc); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	writeStatement := func(stmt statement) error {
+		return statementTpl.Execute(w, streamedStatement{
+			statement:    stmt,
+			EdgeOperator: desc.EdgeOperator,
+		})
+	}
+
+	for vertex, adjacencies := range adjacencyMap {
+		if len(adjacencies) == 0 {
+			if err := writeStatement(statement{Source: vertex}); err != nil {
+				return fmt.Errorf("failed to write statement for vertex %v: %w", vertex, err)
+			}
+			continue
+		}
+
+		for adjacency, edge := range adjacencies {
+			ok, err := rendersEdge(g, vertex, adjacency, edge)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			stmt := statement{
+				Source:     vertex,
+				Target:     adjacency,
+				Weight:     edge.Properties.Weight,
+				Weighted:   g.Traits().IsWeighted,
+				Attributes: edge.Properties.Attributes,
+			}
+			if err := writeStatement(stmt); err != nil {
+				return fmt.Errorf("failed to write statement for edge (%v, %v): %w", vertex, adjacency, err)
+			}
+		}
+	}
+
+	if err := footerTpl.Execute(w, desc); err != nil {
+		return fmt.Errorf("failed to write footer: %w", err)
+	}
+
+	return nil
+}
+
 func generateDOT[K comparable, T any](g graph.Graph[K, T]) (description, error) {
 	desc := description{
 		GraphType:    "graph",
@@ -91,10 +354,19 @@ func generateDOT[K comparable, T any](g graph.Graph[K, T]) (description, error)
 		}
 
 		for adjacency, edge := range adjacencies {
+			ok, err := rendersEdge(g, vertex, adjacency, edge)
+			if err != nil {
+				return desc, err
+			}
+			if !ok {
+				continue
+			}
+
 			stmt := statement{
 				Source:     vertex,
 				Target:     adjacency,
 				Weight:     edge.Properties.Weight,
+				Weighted:   g.Traits().IsWeighted,
 				Attributes: edge.Properties.Attributes,
 			}
 			desc.Statements = append(desc.Statements, stmt)
@@ -104,6 +376,196 @@ func generateDOT[K comparable, T any](g graph.Graph[K, T]) (description, error)
 	return desc, nil
 }
 
+// rendersEdge reports whether the edge from source to target should be rendered. This always is
+// the case, except for an undirected edge that has a "dir" attribute: Such an edge is only rendered
+// in the orientation it has been added with, so that the direction of its arrowhead is correct.
+//
+// Because an undirected graph stores its edges in both orientations, the original orientation is
+// determined by comparing the edge's source value with the value of the source vertex.
+func rendersEdge[K comparable, T any](g graph.Graph[K, T], source, target K, edge graph.Edge[K]) (bool, error) {
+	if g.Traits().IsDirected || source == target {
+		return true, nil
+	}
+
+	if _, ok := edge.Properties.Attributes["dir"]; !ok {
+		return true, nil
+	}
+
+	originalEdge, err := g.Edge(source, target)
+	if err != nil {
+		return false, fmt.Errorf("failed to get edge (%v, %v): %w", source, target, err)
+	}
+
+	sourceValue, err := g.Vertex(source)
+	if err != nil {
+		return false, fmt.Errorf("failed to get vertex %v: %w", source, err)
+	}
+
+	targetValue, err := g.Vertex(target)
+	if err != nil {
+		return false, fmt.Errorf("failed to get vertex %v: %w", target, err)
+	}
+
+	// If both vertex values are equal, the orientation can't be told apart and the edge is
+	// rendered from the lower vertex hash.
+	if reflect.DeepEqual(sourceValue, targetValue) {
+		return keyLess(source, target), nil
+	}
+
+	return reflect.DeepEqual(originalEdge.Source, sourceValue), nil
+}
+
+// groupPalette contains the fill colors assigned by ColorByGroup. The colors are taken from the
+// ColorBrewer Set3 scheme, which is designed for distinguishing categories.
+var groupPalette = []string{
+	"#8dd3c7", "#ffffb3", "#bebada", "#fb8072", "#80b1d3", "#fdb462",
+	"#b3de69", "#fccde5", "#d9d9d9", "#bc80bd", "#ccebc5", "#ffed6f",
+}
+
+// ColorByGroup fills each vertex with a color depending on the group it belongs to. The group of a
+// vertex is determined by the given function, which receives the vertex hash and the vertex value.
+// Each distinct group gets a distinct color from a palette of 12 colors, which is cycled through
+// if there are more groups than colors. The function must have the same types as the graph.
+//
+// The colors are assigned to the groups in their lexicographical order, so rendering the same
+// graph always yields the same colors.
+//
+//	_ = draw.DOT(g, file, draw.ColorByGroup(func(hash string, user User) string {
+//		return user.Team
+//	}))
+func ColorByGroup[K comparable, T any](group func(K, T) string) func(*description) {
+	return func(d *description) {
+		d.groupBy = group
+	}
+}
+
+func colorGroups[K comparable, T any](g graph.Graph[K, T], d *description) error {
+	if d.groupBy == nil {
+		return nil
+	}
+
+	group, ok := d.groupBy.(func(K, T) string)
+	if !ok {
+		return fmt.Errorf("group function has type %T, which doesn't match the graph types", d.groupBy)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+	sortKeys(vertices)
+
+	groups := make(map[K]string, len(vertices))
+	colors := make(map[string]string)
+
+	for _, vertex := range vertices {
+		value, err := g.Vertex(vertex)
+		if err != nil {
+			return fmt.Errorf("could not get vertex with hash %v: %w", vertex, err)
+		}
+
+		groups[vertex] = group(vertex, value)
+		colors[groups[vertex]] = ""
+	}
+
+	names := make([]string, 0, len(colors))
+	for name := range colors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		colors[name] = groupPalette[i%len(groupPalette)]
+	}
+
+	for _, vertex := range vertices {
+		d.Nodes = append(d.Nodes, node{
+			ID: vertex,
+			Attributes: map[string]string{
+				"style":     "filled",
+				"fillcolor": colors[groups[vertex]],
+			},
+		})
+	}
+
+	return nil
+}
+
+// rankVertices groups the vertices by their breadth-first search depth from the root vertex set
+// using RankByDepth and stores the groups as ranks in the description. Unreachable vertices form
+// the last rank. If no root vertex has been set, rankVertices does nothing.
+func rankVertices[K comparable, T any](g graph.Graph[K, T], d *description) error {
+	if d.rankRoot == nil {
+		return nil
+	}
+
+	root, ok := d.rankRoot.(K)
+	if !ok {
+		return fmt.Errorf("rank root %v has type %T, which doesn't match the vertex hash type", d.rankRoot, d.rankRoot)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[root]; !ok {
+		return fmt.Errorf("could not find rank root vertex with hash %v", root)
+	}
+
+	depths := map[K]int{root: 0}
+	levels := [][]K{{root}}
+
+	for depth := 0; depth < len(levels); depth++ {
+		next := make([]K, 0)
+
+		for _, vertex := range levels[depth] {
+			for adjacency := range adjacencyMap[vertex] {
+				if _, ok := depths[adjacency]; ok {
+					continue
+				}
+				depths[adjacency] = depth + 1
+				next = append(next, adjacency)
+			}
+		}
+
+		if len(next) > 0 {
+			levels = append(levels, next)
+		}
+	}
+
+	unreachable := make([]K, 0)
+	for vertex := range adjacencyMap {
+		if _, ok := depths[vertex]; !ok {
+			unreachable = append(unreachable, vertex)
+		}
+	}
+
+	if len(unreachable) > 0 {
+		levels = append(levels, unreachable)
+	}
+
+	d.Ranks = make([][]interface{}, 0, len(levels))
+
+	for _, level := range levels {
+		sortKeys(level)
+
+		rank := make([]interface{}, 0, len(level))
+		for _, vertex := range level {
+			rank = append(rank, vertex)
+		}
+
+		d.Ranks = append(d.Ranks, rank)
+	}
+
+	return nil
+}
+
 func renderDOT(w io.Writer, d description) error {
 	tpl, err := template.New("dotTemplate").Parse(dotTemplate)
 	if err != nil {
@@ -2,6 +2,9 @@ package draw
 
 import (
 	"bytes"
+	"fmt"
+	"io"
+	"sort"
 	"strings"
 	"testing"
 
@@ -54,19 +57,104 @@ func TestGenerateDOT(t *testing.T) {
 				EdgeOperator: "->",
 				Statements: []statement{
 					{
-						Source: 1,
-						Target: 2,
-						Weight: 10,
+						Source:   1,
+						Target:   2,
+						Weight:   10,
+						Weighted: true,
 						Attributes: map[string]string{
 							"color": "red",
 						},
 					},
-					{Source: 1, Target: 3},
+					{Source: 1, Target: 3, Weighted: true},
 					{Source: 2},
 					{Source: 3},
 				},
 			},
 		},
+		"directed graph with undirected edge": {
+			graph:    graph.New(graph.IntHash, graph.Directed()),
+			vertices: []int{1, 2, 3},
+			edges: []graph.Edge[int]{
+				{
+					Source: 1,
+					Target: 2,
+					Properties: graph.EdgeProperties{
+						Attributes: map[string]string{"dir": "none"},
+					},
+				},
+				{Source: 2, Target: 3},
+			},
+			expected: description{
+				GraphType:    "digraph",
+				EdgeOperator: "->",
+				Statements: []statement{
+					{Source: 1, Target: 2, Attributes: map[string]string{"dir": "none"}},
+					{Source: 2, Target: 3},
+					{Source: 3},
+				},
+			},
+		},
+		"undirected graph with directed edge": {
+			graph:    graph.New(graph.IntHash),
+			vertices: []int{1, 2, 3},
+			edges: []graph.Edge[int]{
+				{
+					Source: 2,
+					Target: 1,
+					Properties: graph.EdgeProperties{
+						Attributes: map[string]string{"dir": "forward"},
+					},
+				},
+				{Source: 2, Target: 3},
+			},
+			expected: description{
+				GraphType:    "graph",
+				EdgeOperator: "--",
+				Statements: []statement{
+					{Source: 2, Target: 1, Attributes: map[string]string{"dir": "forward"}},
+					{Source: 2, Target: 3},
+					{Source: 3, Target: 2},
+				},
+			},
+		},
+		"directed, weighted graph with self-loop": {
+			graph:    graph.New(graph.IntHash, graph.Directed(), graph.Weighted()),
+			vertices: []int{1, 2},
+			edges: []graph.Edge[int]{
+				{
+					Source: 1,
+					Target: 1,
+					Properties: graph.EdgeProperties{
+						Weight:     3,
+						Attributes: map[string]string{"label": "retry"},
+					},
+				},
+				{Source: 1, Target: 2, Properties: graph.EdgeProperties{Weight: 1}},
+			},
+			expected: description{
+				GraphType:    "digraph",
+				EdgeOperator: "->",
+				Statements: []statement{
+					{Source: 1, Target: 1, Weight: 3, Weighted: true, Attributes: map[string]string{"label": "retry"}},
+					{Source: 1, Target: 2, Weight: 1, Weighted: true},
+					{Source: 2},
+				},
+			},
+		},
+		"undirected graph with self-loop": {
+			graph:    graph.New(graph.IntHash),
+			vertices: []int{1},
+			edges: []graph.Edge[int]{
+				{Source: 1, Target: 1},
+			},
+			expected: description{
+				GraphType:    "graph",
+				EdgeOperator: "--",
+				Statements: []statement{
+					{Source: 1, Target: 1},
+				},
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -115,14 +203,14 @@ func TestRenderDOT(t *testing.T) {
 				GraphType:    "digraph",
 				EdgeOperator: "->",
 				Statements: []statement{
-					{Source: 1, Target: 2},
-					{Source: 1, Target: 3},
+					{Source: 1, Target: 2, Weight: 5, Weighted: true},
+					{Source: 1, Target: 3, Weight: 0, Weighted: true},
 					{Source: 2},
 					{Source: 3},
 				},
 			},
 			expected: `strict digraph {
-				1 -> 2 [ weight=0 ];
+				1 -> 2 [ weight=5 ];
 				1 -> 3 [ weight=0 ];
 				2 ;
 				3 ;
@@ -152,12 +240,63 @@ func TestRenderDOT(t *testing.T) {
 				},
 			},
 			expected: `strict digraph {
-				1 -> 2 [ color="red", weight=0 ];
-				1 -> 3 [ color="blue", weight=0 ];
+				1 -> 2 [ color="red", ];
+				1 -> 3 [ color="blue", ];
 				2 ;
 				3 ;
 			}`,
 		},
+		"default node and edge attributes": {
+			description: description{
+				GraphType:    "graph",
+				EdgeOperator: "--",
+				NodeAttributes: map[string]string{
+					"shape": "box",
+					"color": "gray",
+				},
+				EdgeAttributes: map[string]string{
+					"style": "dashed",
+				},
+				Statements: []statement{
+					{
+						Source: 1,
+						Target: 2,
+						Attributes: map[string]string{
+							"style": "solid",
+						},
+					},
+				},
+			},
+			expected: `strict graph {
+				node [ color="gray", shape="box", ];
+				edge [ style="dashed", ];
+				1 -- 2 [ style="solid", ];
+			}`,
+		},
+		"ranks": {
+			description: description{
+				GraphType:    "digraph",
+				EdgeOperator: "->",
+				Statements: []statement{
+					{Source: 1, Target: 2},
+					{Source: 2},
+					{Source: 3},
+				},
+				Ranks: [][]interface{}{
+					{1},
+					{2},
+					{3},
+				},
+			},
+			expected: `strict digraph {
+				1 -> 2 ;
+				2 ;
+				3 ;
+				{ rank=same; 1; }
+				{ rank=same; 2; }
+				{ rank=same; 3; }
+			}`,
+		},
 	}
 
 	for name, test := range tests {
@@ -216,5 +355,498 @@ func statementsAreEqual(a, b statement) bool {
 
 	return a.Source == b.Source &&
 		a.Target == b.Target &&
-		a.Weight == b.Weight
+		a.Weight == b.Weight &&
+		a.Weighted == b.Weighted
+}
+
+func TestDOTStream(t *testing.T) {
+	tests := map[string]struct {
+		graph    graph.Graph[int, int]
+		vertices []int
+		edges    []graph.Edge[int]
+		options  []func(*description)
+	}{
+		"3-vertex directed graph": {
+			graph:    graph.New(graph.IntHash, graph.Directed()),
+			vertices: []int{1, 2, 3},
+			edges: []graph.Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+			},
+		},
+		"undirected, weighted graph with attributes": {
+			graph:    graph.New(graph.IntHash, graph.Weighted()),
+			vertices: []int{1, 2, 3, 4},
+			edges: []graph.Edge[int]{
+				{
+					Source: 1,
+					Target: 2,
+					Properties: graph.EdgeProperties{
+						Weight:     10,
+						Attributes: map[string]string{"color": "red"},
+					},
+				},
+				{Source: 2, Target: 3, Properties: graph.EdgeProperties{Weight: 4}},
+			},
+		},
+		"default node and edge attributes": {
+			graph:    graph.New(graph.IntHash, graph.Directed()),
+			vertices: []int{1, 2},
+			edges: []graph.Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			options: []func(*description){
+				DefaultNodeAttributes(map[string]string{"shape": "box"}),
+				DefaultEdgeAttributes(map[string]string{"style": "dashed"}),
+			},
+		},
+		"undirected graph with directed edge": {
+			graph:    graph.New(graph.IntHash),
+			vertices: []int{1, 2, 3},
+			edges: []graph.Edge[int]{
+				{
+					Source: 3,
+					Target: 1,
+					Properties: graph.EdgeProperties{
+						Attributes: map[string]string{"dir": "forward"},
+					},
+				},
+				{Source: 1, Target: 2},
+			},
+		},
+		"ranked by depth": {
+			graph:    graph.New(graph.IntHash, graph.Directed()),
+			vertices: []int{1, 2, 3},
+			edges: []graph.Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			options: []func(*description){
+				RankByDepth(1),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		for _, vertex := range test.vertices {
+			_ = test.graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			options := []func(*graph.EdgeProperties){graph.EdgeWeight(edge.Properties.Weight)}
+			for key, value := range edge.Properties.Attributes {
+				options = append(options, graph.EdgeAttribute(key, value))
+			}
+			if err := test.graph.AddEdge(edge.Source, edge.Target, options...); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		expected := new(bytes.Buffer)
+		if err := DOT(test.graph, expected, test.options...); err != nil {
+			t.Fatalf("%s: failed to render DOT: %s", name, err.Error())
+		}
+
+		output := new(bytes.Buffer)
+		if err := DOTStream(test.graph, output, test.options...); err != nil {
+			t.Fatalf("%s: failed to stream DOT: %s", name, err.Error())
+		}
+
+		// The statement order depends on the map iteration order, so the lines are compared
+		// regardless of their order.
+		if !linesAreEqual(expected.String(), output.String()) {
+			t.Errorf("%s: DOT output expectancy doesn't match: expected %q, got %q", name, expected.String(), output.String())
+		}
+	}
+}
+
+func TestDOT_SelfLoop(t *testing.T) {
+	tests := map[string]struct {
+		graph    graph.Graph[string, string]
+		options  []func(*graph.EdgeProperties)
+		expected string
+	}{
+		"directed, weighted graph": {
+			graph:   graph.New(graph.StringHash, graph.Directed(), graph.Weighted()),
+			options: []func(*graph.EdgeProperties){graph.EdgeWeight(3), graph.EdgeAttribute("label", "retry")},
+			expected: `strict digraph {
+				a -> a [ label="retry", weight=3 ];
+			}`,
+		},
+		"undirected graph": {
+			graph: graph.New(graph.StringHash),
+			expected: `strict graph {
+				a -- a;
+			}`,
+		},
+	}
+
+	for name, test := range tests {
+		_ = test.graph.AddVertex("a")
+
+		if err := test.graph.AddEdge("a", "a", test.options...); err != nil {
+			t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+		}
+
+		for renderer, render := range map[string]func(graph.Graph[string, string], io.Writer, ...func(*description)) error{
+			"DOT":       DOT[string, string],
+			"DOTStream": DOTStream[string, string],
+		} {
+			buf := new(bytes.Buffer)
+			if err := render(test.graph, buf); err != nil {
+				t.Fatalf("%s: failed to render %s: %s", name, renderer, err.Error())
+			}
+
+			output := normalizeOutput(buf.String())
+			expected := normalizeOutput(test.expected)
+
+			if output != expected {
+				t.Errorf("%s: %s output expectancy doesn't match: expected %v, got %v", name, renderer, expected, output)
+			}
+		}
+	}
+}
+
+func TestDOTPath(t *testing.T) {
+	tests := map[string]struct {
+		graph      graph.Graph[string, string]
+		vertices   []string
+		edges      []graph.Edge[string]
+		path       []string
+		expected   string
+		shouldFail bool
+	}{
+		"path in directed, weighted graph": {
+			graph:    graph.New(graph.StringHash, graph.Directed(), graph.Weighted()),
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []graph.Edge[string]{
+				{Source: "A", Target: "B", Properties: graph.EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "C", Properties: graph.EdgeProperties{Weight: 3, Attributes: map[string]string{"color": "red"}}},
+				{Source: "A", Target: "D", Properties: graph.EdgeProperties{Weight: 1}},
+			},
+			path: []string{"A", "B", "C"},
+			expected: `strict digraph {
+				A -> B [ weight=2 ];
+				B -> C [ color="red", weight=3 ];
+			}`,
+		},
+		"path in undirected graph": {
+			graph:    graph.New(graph.StringHash),
+			vertices: []string{"A", "B", "C"},
+			edges: []graph.Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+			},
+			path: []string{"C", "B"},
+			expected: `strict graph {
+				C -- B ;
+			}`,
+		},
+		"single vertex": {
+			graph:    graph.New(graph.StringHash),
+			vertices: []string{"A", "B"},
+			path:     []string{"A"},
+			expected: `strict graph {
+				A ;
+			}`,
+		},
+		"missing vertex": {
+			graph:      graph.New(graph.StringHash),
+			vertices:   []string{"A"},
+			path:       []string{"A", "X"},
+			shouldFail: true,
+		},
+		"missing edge": {
+			graph:      graph.New(graph.StringHash, graph.Directed()),
+			vertices:   []string{"A", "B"},
+			edges:      []graph.Edge[string]{{Source: "A", Target: "B"}},
+			path:       []string{"B", "A"},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		for _, vertex := range test.vertices {
+			_ = test.graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			options := []func(*graph.EdgeProperties){graph.EdgeWeight(edge.Properties.Weight)}
+			for key, value := range edge.Properties.Attributes {
+				options = append(options, graph.EdgeAttribute(key, value))
+			}
+			if err := test.graph.AddEdge(edge.Source, edge.Target, options...); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		buf := new(bytes.Buffer)
+		err := DOTPath(test.graph, test.path, buf)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		output := normalizeOutput(buf.String())
+		expected := normalizeOutput(test.expected)
+
+		if output != expected {
+			t.Errorf("%s: DOT output expectancy doesn't match: expected %v, got %v", name, expected, output)
+		}
+	}
+}
+
+func TestRankVertices(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []int
+		edges         []graph.Edge[int]
+		root          interface{}
+		expectedRanks [][]interface{}
+		shouldFail    bool
+	}{
+		"directed tree with unreachable vertices": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4, 5, 6},
+			edges: []graph.Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 6, Target: 1},
+			},
+			root: 1,
+			expectedRanks: [][]interface{}{
+				{1},
+				{2, 3},
+				{4},
+				{5, 6},
+			},
+		},
+		"undirected path from its middle": {
+			vertices: []int{1, 2, 3},
+			edges: []graph.Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			root: 2,
+			expectedRanks: [][]interface{}{
+				{2},
+				{1, 3},
+			},
+		},
+		"missing root": {
+			vertices:   []int{1},
+			root:       2,
+			shouldFail: true,
+		},
+		"root of wrong type": {
+			vertices:   []int{1},
+			root:       "1",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var g graph.Graph[int, int]
+		if test.isDirected {
+			g = graph.New(graph.IntHash, graph.Directed())
+		} else {
+			g = graph.New(graph.IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = g.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		desc := description{rankRoot: test.root}
+		err := rankVertices(g, &desc)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if fmt.Sprint(desc.Ranks) != fmt.Sprint(test.expectedRanks) {
+			t.Errorf("%s: ranks expectancy doesn't match: expected %v, got %v", name, test.expectedRanks, desc.Ranks)
+		}
+	}
+}
+
+func TestColorGroups(t *testing.T) {
+	tests := map[string]struct {
+		vertices       []int
+		groupBy        interface{}
+		expectedColors map[int]string
+		shouldFail     bool
+	}{
+		"groups in lexicographical order": {
+			vertices: []int{1, 2, 3, 4},
+			groupBy: func(hash, value int) string {
+				if hash%2 == 0 {
+					return "even"
+				}
+				return "odd"
+			},
+			expectedColors: map[int]string{
+				1: groupPalette[1],
+				2: groupPalette[0],
+				3: groupPalette[1],
+				4: groupPalette[0],
+			},
+		},
+		"more groups than colors": {
+			vertices: []int{10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22},
+			groupBy: func(hash, value int) string {
+				return fmt.Sprint(hash)
+			},
+			expectedColors: map[int]string{
+				10: groupPalette[0],
+				21: groupPalette[11],
+				22: groupPalette[0],
+			},
+		},
+		"group function of wrong type": {
+			vertices:   []int{1},
+			groupBy:    func(hash string, value int) string { return hash },
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		g := graph.New(graph.IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = g.AddVertex(vertex)
+		}
+
+		desc := description{groupBy: test.groupBy}
+		err := colorGroups(g, &desc)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(desc.Nodes) != len(test.vertices) {
+			t.Fatalf("%s: node count doesn't match: expected %v, got %v", name, len(test.vertices), len(desc.Nodes))
+		}
+
+		for _, node := range desc.Nodes {
+			if node.Attributes["style"] != "filled" {
+				t.Errorf("%s: style of node %v doesn't match: expected filled, got %v", name, node.ID, node.Attributes["style"])
+			}
+
+			expectedColor, ok := test.expectedColors[node.ID.(int)]
+			if !ok {
+				continue
+			}
+
+			if node.Attributes["fillcolor"] != expectedColor {
+				t.Errorf("%s: fill color of node %v doesn't match: expected %v, got %v", name, node.ID, expectedColor, node.Attributes["fillcolor"])
+			}
+		}
+	}
+}
+
+// TestColorGroups_Distinct checks that distinct groups get distinct fill colors as long as the
+// palette has enough colors.
+func TestColorGroups_Distinct(t *testing.T) {
+	groups := []string{"backend", "blue", "frontend", "ops"}
+
+	g := graph.New(graph.StringHash)
+	for _, group := range groups {
+		_ = g.AddVertex(group)
+	}
+
+	desc := description{groupBy: func(hash string, value string) string {
+		return hash
+	}}
+
+	if err := colorGroups(g, &desc); err != nil {
+		t.Fatalf("failed to color groups: %s", err.Error())
+	}
+
+	groupsByColor := make(map[string]interface{})
+
+	for _, node := range desc.Nodes {
+		color := node.Attributes["fillcolor"]
+		if other, ok := groupsByColor[color]; ok {
+			t.Errorf("groups %v and %v have the same fill color %v", other, node.ID, color)
+		}
+		groupsByColor[color] = node.ID
+	}
+
+	if len(groupsByColor) != len(groups) {
+		t.Errorf("color count expectancy doesn't match: expected %v, got %v", len(groups), len(groupsByColor))
+	}
+}
+
+func TestDOT_ColorByGroup(t *testing.T) {
+	g := graph.New(graph.StringHash, graph.Directed())
+	_ = g.AddVertex("a")
+	_ = g.AddVertex("b")
+	_ = g.AddEdge("a", "b")
+
+	group := func(hash string, value string) string {
+		return hash
+	}
+
+	var first, second bytes.Buffer
+
+	if err := DOT(g, &first, ColorByGroup(group)); err != nil {
+		t.Fatalf("failed to render DOT: %s", err.Error())
+	}
+
+	if err := DOT(g, &second, ColorByGroup(group)); err != nil {
+		t.Fatalf("failed to render DOT: %s", err.Error())
+	}
+
+	// The edge statements are rendered in an arbitrary order, so only the lines are compared.
+	if !linesAreEqual(first.String(), second.String()) {
+		t.Errorf("output of repeated renders doesn't match:\n%s\n%s", first.String(), second.String())
+	}
+
+	for _, expected := range []string{
+		`a [ fillcolor="#8dd3c7", style="filled", ];`,
+		`b [ fillcolor="#ffffb3", style="filled", ];`,
+	} {
+		if !strings.Contains(first.String(), expected) {
+			t.Errorf("output doesn't contain node statement %s:\n%s", expected, first.String())
+		}
+	}
+
+	var stream bytes.Buffer
+	if err := DOTStream(g, &stream, ColorByGroup(group)); err != nil {
+		t.Fatalf("failed to render DOT stream: %s", err.Error())
+	}
+
+	if !strings.Contains(stream.String(), `a [ fillcolor="#8dd3c7", style="filled", ];`) {
+		t.Errorf("streamed output doesn't contain node statement:\n%s", stream.String())
+	}
+}
+
+func linesAreEqual(a, b string) bool {
+	aLines := strings.Split(a, "\n")
+	bLines := strings.Split(b, "\n")
+
+	sort.Strings(aLines)
+	sort.Strings(bLines)
+
+	return strings.Join(aLines, "\n") == strings.Join(bLines, "\n")
 }
@@ -0,0 +1,162 @@
+package draw
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/dominikbraun/graph"
+)
+
+// Pajek renders the given graph in the Pajek .net format into an io.Writer. The output consists of
+// a *Vertices section followed by an *Arcs section for directed graphs or an *Edges section for
+// undirected graphs. Each edge line carries the edge weight.
+//
+// Pajek identifies vertices by 1-based integers rather than by arbitrary values. Pajek therefore
+// builds a key-to-index mapping by sorting the vertex hashes in ascending order using
+// graph.SortedVertices: Integer, float and string hashes, including named types such as
+// `type ID int`, are sorted by their natural order, and all other hashes are sorted by their string
+// representation as obtained by fmt. The first vertex in that order gets the number 1, the second
+// one the number 2, and so on. The original hash is written as the vertex label, so that the
+// mapping can be reversed when reading the file.
+//
+// Edges are written in ascending order of their source and target numbers. Since the ordering only
+// depends on the vertex hashes, rendering the same graph twice yields identical output.
+//
+//	g := graph.New(graph.StringHash, graph.Directed())
+//
+//	_ = g.AddVertex("A")
+//	_ = g.AddVertex("B")
+//	_ = g.AddEdge("A", "B", graph.EdgeWeight(3))
+//
+//	_ = draw.Pajek(g, os.Stdout)
+//
+// The example above prints the following Pajek description:
+//
+//	*Vertices 2
+//	1 "A"
+//	2 "B"
+//	*Arcs
+//	1 2 3
+func Pajek[K comparable, T any](g graph.Graph[K, T], w io.Writer) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices, err := graph.SortedVertices(g, nil)
+	if err != nil {
+		return fmt.Errorf("failed to sort vertices: %w", err)
+	}
+
+	indices := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		indices[vertex] = i + 1
+	}
+
+	buf := bufio.NewWriter(w)
+
+	fmt.Fprintf(buf, "*Vertices %d\n", len(vertices))
+
+	for _, vertex := range vertices {
+		// Pajek doesn't support escaping quotes within labels, so they are replaced.
+		label := strings.ReplaceAll(fmt.Sprint(vertex), `"`, `'`)
+		fmt.Fprintf(buf, "%d \"%s\"\n", indices[vertex], label)
+	}
+
+	isDirected := g.Traits().IsDirected
+
+	if isDirected {
+		fmt.Fprintln(buf, "*Arcs")
+	} else {
+		fmt.Fprintln(buf, "*Edges")
+	}
+
+	for _, vertex := range vertices {
+		targets := make([]K, 0, len(adjacencyMap[vertex]))
+
+		for target := range adjacencyMap[vertex] {
+			// An undirected edge is contained in the adjacency map twice. It is only written
+			// once, namely from the vertex with the smaller number to the one with the greater.
+			if !isDirected && indices[target] < indices[vertex] {
+				continue
+			}
+			targets = append(targets, target)
+		}
+
+		sort.Slice(targets, func(i, j int) bool {
+			return indices[targets[i]] < indices[targets[j]]
+		})
+
+		for _, target := range targets {
+			edge := adjacencyMap[vertex][target]
+			fmt.Fprintf(buf, "%d %d %d\n", indices[vertex], indices[target], edge.Properties.Weight)
+		}
+	}
+
+	return buf.Flush()
+}
+
+// sortKeys sorts the given vertex hashes in ascending order. Hashes of a numeric or string type,
+// including named types such as `type ID int`, are sorted by their natural order. All other hashes
+// are sorted by their string representation.
+func sortKeys[K comparable](keys []K) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keyLess(keys[i], keys[j])
+	})
+}
+
+// keyLess reports whether the hash a is smaller than the hash b. It uses the same order as
+// graph.SortedVertices, so that all renderers order the vertices consistently.
+func keyLess[K comparable](a, b K) bool {
+	switch aValue := any(a).(type) {
+	case int:
+		return aValue < any(b).(int)
+	case int8:
+		return aValue < any(b).(int8)
+	case int16:
+		return aValue < any(b).(int16)
+	case int32:
+		return aValue < any(b).(int32)
+	case int64:
+		return aValue < any(b).(int64)
+	case uint:
+		return aValue < any(b).(uint)
+	case uint8:
+		return aValue < any(b).(uint8)
+	case uint16:
+		return aValue < any(b).(uint16)
+	case uint32:
+		return aValue < any(b).(uint32)
+	case uint64:
+		return aValue < any(b).(uint64)
+	case float32:
+		return aValue < any(b).(float32)
+	case float64:
+		return aValue < any(b).(float64)
+	case string:
+		return aValue < any(b).(string)
+	}
+
+	// Named types such as `type ID int` don't match any of the cases above. They are compared by
+	// the kind of their underlying type, so that they are sorted by their natural order as well.
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	if aValue.Kind() == bValue.Kind() {
+		switch aValue.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return aValue.Int() < bValue.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return aValue.Uint() < bValue.Uint()
+		case reflect.Float32, reflect.Float64:
+			return aValue.Float() < bValue.Float()
+		case reflect.String:
+			return aValue.String() < bValue.String()
+		}
+	}
+
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
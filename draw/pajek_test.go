@@ -0,0 +1,103 @@
+package draw
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+func TestPajek(t *testing.T) {
+	tests := map[string]struct {
+		graph    graph.Graph[int, int]
+		vertices []int
+		edges    []graph.Edge[int]
+		expected string
+	}{
+		"directed weighted graph": {
+			graph:    graph.New(graph.IntHash, graph.Directed(), graph.Weighted()),
+			vertices: []int{10, 2, 3},
+			edges: []graph.Edge[int]{
+				{Source: 10, Target: 2, Properties: graph.EdgeProperties{Weight: 4}},
+				{Source: 2, Target: 3, Properties: graph.EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 10, Properties: graph.EdgeProperties{Weight: 7}},
+			},
+			expected: "*Vertices 3\n" +
+				"1 \"2\"\n" +
+				"2 \"3\"\n" +
+				"3 \"10\"\n" +
+				"*Arcs\n" +
+				"1 2 1\n" +
+				"1 3 7\n" +
+				"3 1 4\n",
+		},
+		"undirected graph": {
+			graph:    graph.New(graph.IntHash),
+			vertices: []int{1, 2, 3, 4},
+			edges: []graph.Edge[int]{
+				{Source: 2, Target: 1},
+				{Source: 1, Target: 3, Properties: graph.EdgeProperties{Weight: 2}},
+			},
+			expected: "*Vertices 4\n" +
+				"1 \"1\"\n" +
+				"2 \"2\"\n" +
+				"3 \"3\"\n" +
+				"4 \"4\"\n" +
+				"*Edges\n" +
+				"1 2 0\n" +
+				"1 3 2\n",
+		},
+	}
+
+	for name, test := range tests {
+		for _, vertex := range test.vertices {
+			_ = test.graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := test.graph.AddEdge(edge.Source, edge.Target, graph.EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		buf := new(bytes.Buffer)
+
+		if err := Pajek(test.graph, buf); err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if buf.String() != test.expected {
+			t.Errorf("%s: Pajek output expectancy doesn't match: expected %q, got %q", name, test.expected, buf.String())
+		}
+	}
+}
+
+type vertexID int
+
+// TestPajek_NamedKey checks that hashes of a named integer type are numbered in their natural order
+// rather than in the order of their string representation, which would place 10 before 9.
+func TestPajek_NamedKey(t *testing.T) {
+	g := graph.New(func(id vertexID) vertexID {
+		return id
+	}, graph.Directed())
+
+	_ = g.AddVertex(10)
+	_ = g.AddVertex(9)
+	_ = g.AddEdge(9, 10)
+
+	buf := new(bytes.Buffer)
+
+	if err := Pajek(g, buf); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	expected := "*Vertices 2\n" +
+		"1 \"9\"\n" +
+		"2 \"10\"\n" +
+		"*Arcs\n" +
+		"1 2 0\n"
+
+	if buf.String() != expected {
+		t.Errorf("Pajek output expectancy doesn't match: expected %q, got %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,118 @@
+package draw
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/dominikbraun/graph"
+)
+
+// Tree renders the given rooted tree as an indented ASCII tree into an io.Writer, starting from the
+// given root vertex. Each vertex is printed on its own line using the given label function. If the
+// label function is nil, the vertex value is formatted using fmt.
+//
+//	g := graph.New(graph.StringHash, graph.Directed(), graph.Tree())
+//
+//	_ = g.AddVertex("root")
+//	_ = g.AddVertex("a")
+//	_ = g.AddVertex("b")
+//	_ = g.AddVertex("c")
+//
+//	_ = g.AddEdge("root", "a")
+//	_ = g.AddEdge("root", "b")
+//	_ = g.AddEdge("a", "c")
+//
+//	_ = draw.Tree(g, "root", os.Stdout, nil)
+//
+// The example above prints the following tree:
+//
+//	root
+//	├── a
+//	│   └── c
+//	└── b
+//
+// The children of a vertex are printed in ascending order of their hashes. The graph must have the
+// Rooted and Acyclic traits, which can be set at once using graph.Tree. For undirected graphs, the
+// children of a vertex are all adjacent vertices except for its parent. If a vertex is reachable
+// via more than one path, which would be the case in a DAG that isn't a tree, Tree returns an error
+// instead of printing that vertex multiple times.
+func Tree[K comparable, T any](g graph.Graph[K, T], root K, w io.Writer, label func(T) string) error {
+	if !g.Traits().IsRooted || !g.Traits().IsAcyclic {
+		return errors.New("tree can only be drawn for rooted, acyclic graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[root]; !ok {
+		return fmt.Errorf("could not find root vertex with hash %v", root)
+	}
+
+	if label == nil {
+		label = func(value T) string {
+			return fmt.Sprint(value)
+		}
+	}
+
+	buf := bufio.NewWriter(w)
+	visited := make(map[K]bool)
+
+	var drawVertex func(vertex, parent K, prefix, connector string) error
+
+	drawVertex = func(vertex, parent K, prefix, connector string) error {
+		if visited[vertex] {
+			return fmt.Errorf("vertex %v is reachable via multiple paths, so the graph is not a tree", vertex)
+		}
+		visited[vertex] = true
+
+		value, err := g.Vertex(vertex)
+		if err != nil {
+			return fmt.Errorf("could not get vertex with hash %v: %w", vertex, err)
+		}
+
+		fmt.Fprintf(buf, "%s%s%s\n", prefix, connector, label(value))
+
+		children := make([]K, 0, len(adjacencyMap[vertex]))
+
+		for adjacency := range adjacencyMap[vertex] {
+			// In an undirected graph, the parent vertex is adjacent to its child as well. The root
+			// vertex is its own parent, which is fine since an acyclic graph has no self-loops.
+			if !g.Traits().IsDirected && adjacency == parent {
+				continue
+			}
+			children = append(children, adjacency)
+		}
+
+		sortKeys(children)
+
+		childPrefix := prefix
+		switch connector {
+		case "├── ":
+			childPrefix += "│   "
+		case "└── ":
+			childPrefix += "    "
+		}
+
+		for i, child := range children {
+			childConnector := "├── "
+			if i == len(children)-1 {
+				childConnector = "└── "
+			}
+			if err := drawVertex(child, vertex, childPrefix, childConnector); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := drawVertex(root, root, "", ""); err != nil {
+		return err
+	}
+
+	return buf.Flush()
+}
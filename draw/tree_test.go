@@ -0,0 +1,95 @@
+package draw
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+func TestTree(t *testing.T) {
+	tests := map[string]struct {
+		graph      graph.Graph[string, string]
+		vertices   []string
+		edges      []graph.Edge[string]
+		root       string
+		expected   string
+		shouldFail bool
+	}{
+		"directed tree": {
+			graph:    graph.New(graph.StringHash, graph.Directed(), graph.Tree()),
+			vertices: []string{"root", "a", "b", "c", "d"},
+			edges: []graph.Edge[string]{
+				{Source: "root", Target: "a"},
+				{Source: "root", Target: "b"},
+				{Source: "a", Target: "c"},
+				{Source: "a", Target: "d"},
+			},
+			root: "root",
+			expected: "root\n" +
+				"├── a\n" +
+				"│   ├── c\n" +
+				"│   └── d\n" +
+				"└── b\n",
+		},
+		"undirected tree": {
+			graph:    graph.New(graph.StringHash, graph.Tree()),
+			vertices: []string{"root", "a", "b", "c"},
+			edges: []graph.Edge[string]{
+				{Source: "root", Target: "a"},
+				{Source: "b", Target: "root"},
+				{Source: "b", Target: "c"},
+			},
+			root: "root",
+			expected: "root\n" +
+				"├── a\n" +
+				"└── b\n" +
+				"    └── c\n",
+		},
+		"DAG with shared vertex": {
+			graph:    graph.New(graph.StringHash, graph.Directed(), graph.Tree()),
+			vertices: []string{"root", "a", "b", "c"},
+			edges: []graph.Edge[string]{
+				{Source: "root", Target: "a"},
+				{Source: "root", Target: "b"},
+				{Source: "a", Target: "c"},
+				{Source: "b", Target: "c"},
+			},
+			root:       "root",
+			shouldFail: true,
+		},
+		"graph without tree traits": {
+			graph:      graph.New(graph.StringHash, graph.Directed()),
+			vertices:   []string{"root"},
+			root:       "root",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		for _, vertex := range test.vertices {
+			_ = test.graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := test.graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		buf := new(bytes.Buffer)
+		err := Tree(test.graph, test.root, buf, nil)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if buf.String() != test.expected {
+			t.Errorf("%s: tree expectancy doesn't match: expected %q, got %q", name, test.expected, buf.String())
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// EdgeConnectivity computes the edge connectivity of an undirected graph, which is the minimum
+// number of edges that need to be removed to disconnect the graph. A disconnected graph has an
+// edge connectivity of 0, and a cycle graph has an edge connectivity of 2.
+//
+// The edge connectivity is determined by computing the maximum flow with unit capacities from a
+// fixed vertex to each other vertex, and by taking the minimum of these values. The graph must have
+// at least two vertices, otherwise an error will be returned.
+func EdgeConnectivity[K comparable, T any](g Graph[K, T]) (int, error) {
+	if g.Traits().IsDirected {
+		return 0, errors.New("edge connectivity can only be computed for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if len(adjacencyMap) < 2 {
+		return 0, errors.New("edge connectivity requires at least two vertices")
+	}
+
+	capacities := make(map[K]map[K]int, len(adjacencyMap))
+
+	for vertex, adjacencies := range adjacencyMap {
+		capacities[vertex] = make(map[K]int, len(adjacencies))
+		for adjacency := range adjacencies {
+			if adjacency != vertex {
+				capacities[vertex][adjacency] = 1
+			}
+		}
+	}
+
+	var source K
+	for vertex := range adjacencyMap {
+		source = vertex
+		break
+	}
+
+	connectivity := -1
+
+	for sink := range adjacencyMap {
+		if sink == source {
+			continue
+		}
+
+		flow, _ := maxFlow(capacities, source, sink)
+
+		if connectivity == -1 || flow < connectivity {
+			connectivity = flow
+		}
+	}
+
+	return connectivity, nil
+}
+
+// VertexConnectivity computes the vertex connectivity of an undirected graph, which is the minimum
+// number of vertices that need to be removed to disconnect the graph or to leave only a single
+// vertex. A disconnected graph has a vertex connectivity of 0, and a complete graph with n vertices
+// has a vertex connectivity of n-1.
+//
+// For each pair of non-adjacent vertices, the number of vertex-disjoint paths between them is
+// determined by computing the maximum flow in a network where each vertex is split into an ingoing
+// and outgoing part joined by an edge with capacity 1. The vertex connectivity is the minimum of
+// these values. The graph must have at least two vertices, otherwise an error will be returned.
+func VertexConnectivity[K comparable, T any](g Graph[K, T]) (int, error) {
+	if g.Traits().IsDirected {
+		return 0, errors.New("vertex connectivity can only be computed for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if len(adjacencyMap) < 2 {
+		return 0, errors.New("vertex connectivity requires at least two vertices")
+	}
+
+	order := len(adjacencyMap)
+	capacities := make(map[splitVertex[K]]map[splitVertex[K]]int, 2*order)
+
+	for vertex, adjacencies := range adjacencyMap {
+		in := splitVertex[K]{hash: vertex}
+		out := splitVertex[K]{hash: vertex, isOut: true}
+
+		capacities[in] = map[splitVertex[K]]int{out: 1}
+		capacities[out] = make(map[splitVertex[K]]int, len(adjacencies))
+
+		for adjacency := range adjacencies {
+			if adjacency != vertex {
+				capacities[out][splitVertex[K]{hash: adjacency}] = order
+			}
+		}
+	}
+
+	// In a complete graph, there are no non-adjacent vertices, and the connectivity is n-1.
+	connectivity := order - 1
+
+	for source := range adjacencyMap {
+		for sink := range adjacencyMap {
+			if sink == source {
+				continue
+			}
+			if _, ok := adjacencyMap[source][sink]; ok {
+				continue
+			}
+
+			// Starting at the outgoing part of the source and ending at the ingoing part of the
+			// sink ensures that these two vertices themselves are never removed.
+			sourceOut := splitVertex[K]{hash: source, isOut: true}
+			sinkIn := splitVertex[K]{hash: sink}
+
+			flow, _ := maxFlow(capacities, sourceOut, sinkIn)
+
+			if flow < connectivity {
+				connectivity = flow
+			}
+		}
+	}
+
+	return connectivity, nil
+}
+
+// MinCutEdges computes a minimum cut between the source and the sink vertex, i.e. a set of edges
+// with minimal total capacity whose removal disconnects the sink from the source. It returns the
+// capacity of the cut, which equals the maximum flow from the source to the sink, along with the
+// edges crossing the cut. Each edge is returned as a pair of its source and target hash, sorted in
+// ascending order.
+//
+// If the graph is weighted, the edge weights are used as capacities. Otherwise, each edge has a
+// capacity of 1, and the cut capacity is the number of edges to remove. Edges with a capacity of 0
+// never carry any flow and are not part of the cut. In an undirected graph, each edge can be used
+// in both directions and is returned with its endpoint on the source side first.
+//
+// The cut is the one closest to the source: It consists of the saturated edges leaving the set of
+// vertices that are still reachable from the source in the residual network.
+func MinCutEdges[K comparable, T any](g Graph[K, T], source, sink K) (int, [][2]K, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return 0, nil, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	if _, ok := adjacencyMap[sink]; !ok {
+		return 0, nil, fmt.Errorf("could not find sink vertex with hash %v", sink)
+	}
+
+	if source == sink {
+		return 0, nil, errors.New("source and sink must be different vertices")
+	}
+
+	isWeighted := g.Traits().IsWeighted
+	capacities := make(map[K]map[K]int, len(adjacencyMap))
+
+	for vertex, adjacencies := range adjacencyMap {
+		capacities[vertex] = make(map[K]int, len(adjacencies))
+		for adjacency, edge := range adjacencies {
+			if adjacency == vertex {
+				continue
+			}
+
+			capacity := 1
+			if isWeighted {
+				capacity = edge.Properties.Weight
+			}
+
+			if capacity < 0 {
+				return 0, nil, fmt.Errorf("edge (%v, %v) has a negative capacity", vertex, adjacency)
+			}
+
+			if capacity > 0 {
+				capacities[vertex][adjacency] = capacity
+			}
+		}
+	}
+
+	flow, residual := maxFlow(capacities, source, sink)
+
+	reachable := map[K]bool{source: true}
+	stack := []K{source}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for next, capacity := range residual[current] {
+			if capacity <= 0 || reachable[next] {
+				continue
+			}
+			reachable[next] = true
+			stack = append(stack, next)
+		}
+	}
+
+	cut := make([][2]K, 0)
+
+	for vertex := range reachable {
+		for adjacency := range capacities[vertex] {
+			if !reachable[adjacency] {
+				cut = append(cut, [2]K{vertex, adjacency})
+			}
+		}
+	}
+
+	sort.Slice(cut, func(i, j int) bool {
+		if cut[i][0] != cut[j][0] {
+			return keyLess(cut[i][0], cut[j][0])
+		}
+		return keyLess(cut[i][1], cut[j][1])
+	})
+
+	return flow, cut, nil
+}
+
+// splitVertex is either the ingoing or the outgoing part of a vertex that has been split into two
+// vertices in a flow network.
+type splitVertex[K comparable] struct {
+	hash  K
+	isOut bool
+}
+
+// maxFlow computes the maximum flow from the source to the sink vertex in the flow network given by
+// the capacities using the Edmonds-Karp algorithm. The capacities are not modified. maxFlow returns
+// the value of the maximum flow along with the residual capacities of the network.
+func maxFlow[N comparable](capacities map[N]map[N]int, source, sink N) (int, map[N]map[N]int) {
+	residual := make(map[N]map[N]int, len(capacities))
+
+	ensure := func(vertex N) {
+		if _, ok := residual[vertex]; !ok {
+			residual[vertex] = make(map[N]int)
+		}
+	}
+
+	for vertex, targets := range capacities {
+		ensure(vertex)
+		for target, capacity := range targets {
+			ensure(target)
+			residual[vertex][target] += capacity
+			// Create the reverse edge so that flow can be pushed back.
+			if _, ok := residual[target][vertex]; !ok {
+				residual[target][vertex] = 0
+			}
+		}
+	}
+
+	flow := 0
+
+	for {
+		// Find the shortest augmenting path using a BFS in the residual network.
+		parents := map[N]N{source: source}
+		queue := []N{source}
+
+		for len(queue) > 0 && !hasKey(parents, sink) {
+			current := queue[0]
+			queue = queue[1:]
+
+			for next, capacity := range residual[current] {
+				if capacity <= 0 || hasKey(parents, next) {
+					continue
+				}
+				parents[next] = current
+				queue = append(queue, next)
+			}
+		}
+
+		if !hasKey(parents, sink) {
+			return flow, residual
+		}
+
+		bottleneck := -1
+
+		for vertex := sink; vertex != source; vertex = parents[vertex] {
+			capacity := residual[parents[vertex]][vertex]
+			if bottleneck == -1 || capacity < bottleneck {
+				bottleneck = capacity
+			}
+		}
+
+		for vertex := sink; vertex != source; vertex = parents[vertex] {
+			residual[parents[vertex]][vertex] -= bottleneck
+			residual[vertex][parents[vertex]] += bottleneck
+		}
+
+		flow += bottleneck
+	}
+}
+
+func hasKey[K comparable, V any](m map[K]V, key K) bool {
+	_, ok := m[key]
+	return ok
+}
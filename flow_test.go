@@ -0,0 +1,319 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestEdgeAndVertexConnectivity(t *testing.T) {
+	tests := map[string]struct {
+		vertices                   []int
+		edges                      []Edge[int]
+		expectedEdgeConnectivity   int
+		expectedVertexConnectivity int
+		shouldFail                 bool
+	}{
+		"cycle graph": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 1},
+			},
+			expectedEdgeConnectivity:   2,
+			expectedVertexConnectivity: 2,
+		},
+		"path graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedEdgeConnectivity:   1,
+			expectedVertexConnectivity: 1,
+		},
+		"complete graph with 4 vertices": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expectedEdgeConnectivity:   3,
+			expectedVertexConnectivity: 3,
+		},
+		"two triangles sharing a vertex": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 3},
+			},
+			expectedEdgeConnectivity:   2,
+			expectedVertexConnectivity: 1,
+		},
+		"disconnected graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedEdgeConnectivity:   0,
+			expectedVertexConnectivity: 0,
+		},
+		"single vertex": {
+			vertices:   []int{1},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		edgeConnectivity, err := EdgeConnectivity(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		vertexConnectivity, err := VertexConnectivity(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if edgeConnectivity != test.expectedEdgeConnectivity {
+			t.Errorf("%s: edge connectivity expectancy doesn't match: expected %v, got %v", name, test.expectedEdgeConnectivity, edgeConnectivity)
+		}
+
+		if vertexConnectivity != test.expectedVertexConnectivity {
+			t.Errorf("%s: vertex connectivity expectancy doesn't match: expected %v, got %v", name, test.expectedVertexConnectivity, vertexConnectivity)
+		}
+	}
+}
+
+func TestDirectedEdgeConnectivity(t *testing.T) {
+	graph := New(IntHash, Directed())
+
+	if _, err := EdgeConnectivity(graph); err == nil {
+		t.Errorf("expected error for directed graph")
+	}
+
+	if _, err := VertexConnectivity(graph); err == nil {
+		t.Errorf("expected error for directed graph")
+	}
+}
+
+func TestMinCutEdges(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		isWeighted    bool
+		vertices      []int
+		edges         []Edge[int]
+		source        int
+		sink          int
+		expectedValue int
+		expectedCut   [][2]int
+		shouldFail    bool
+	}{
+		"directed weighted network": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 5}},
+				{Source: 2, Target: 4, Properties: EdgeProperties{Weight: 2}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 3}},
+			},
+			source:        1,
+			sink:          4,
+			expectedValue: 5,
+			expectedCut:   [][2]int{{1, 2}, {1, 3}},
+		},
+		"bottleneck in the middle": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 10}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 10}},
+				{Source: 2, Target: 4, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 2}},
+				{Source: 4, Target: 5, Properties: EdgeProperties{Weight: 10}},
+			},
+			source:        1,
+			sink:          5,
+			expectedValue: 3,
+			expectedCut:   [][2]int{{2, 4}, {3, 4}},
+		},
+		"unweighted graph counts edges": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 10}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 10}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 10}},
+			},
+			source:        1,
+			sink:          3,
+			expectedValue: 2,
+			expectedCut:   [][2]int{{1, 2}, {1, 3}},
+		},
+		"undirected path": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 2, Target: 1},
+				{Source: 3, Target: 2},
+			},
+			source:        1,
+			sink:          3,
+			expectedValue: 1,
+			expectedCut:   [][2]int{{1, 2}},
+		},
+		"sink not reachable": {
+			isDirected:    true,
+			vertices:      []int{1, 2},
+			edges:         []Edge[int]{{Source: 2, Target: 1}},
+			source:        1,
+			sink:          2,
+			expectedValue: 0,
+			expectedCut:   [][2]int{},
+		},
+		"negative capacity": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{1, 2},
+			edges:      []Edge[int]{{Source: 1, Target: 2, Properties: EdgeProperties{Weight: -1}}},
+			source:     1,
+			sink:       2,
+			shouldFail: true,
+		},
+		"source equal to sink": {
+			isDirected: true,
+			vertices:   []int{1},
+			source:     1,
+			sink:       1,
+			shouldFail: true,
+		},
+		"unknown sink": {
+			isDirected: true,
+			vertices:   []int{1},
+			source:     1,
+			sink:       2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+		if test.isWeighted {
+			options = append(options, Weighted())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		value, cut, err := MinCutEdges(graph, test.source, test.sink)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if value != test.expectedValue {
+			t.Errorf("%s: value expectancy doesn't match: expected %v, got %v", name, test.expectedValue, value)
+		}
+
+		if !orderedSlicesAreEqual(cut, test.expectedCut) {
+			t.Errorf("%s: cut expectancy doesn't match: expected %v, got %v", name, test.expectedCut, cut)
+		}
+	}
+}
+
+// TestMinCutEdges_Random checks on random graphs that the capacity of the returned edges equals
+// the cut value and that removing them disconnects the sink from the source.
+func TestMinCutEdges_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for round := 0; round < 50; round++ {
+		n := 10
+		graph := New(IntHash, Directed(), Weighted())
+
+		for i := 0; i < n; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		for i := 0; i < 3*n; i++ {
+			_ = graph.AddEdge(rng.Intn(n), rng.Intn(n), EdgeWeight(rng.Intn(10)))
+		}
+
+		value, cut, err := MinCutEdges(graph, 0, n-1)
+		if err != nil {
+			t.Fatalf("round %d: unexpected error: %s", round, err.Error())
+		}
+
+		capacity := 0
+		for _, edge := range cut {
+			e, err := graph.Edge(edge[0], edge[1])
+			if err != nil {
+				t.Fatalf("round %d: cut contains non-existent edge %v", round, edge)
+			}
+			capacity += e.Properties.Weight
+
+			if err := graph.RemoveEdge(edge[0], edge[1]); err != nil {
+				t.Fatalf("round %d: failed to remove edge %v: %s", round, edge, err.Error())
+			}
+		}
+
+		if capacity != value {
+			t.Errorf("round %d: cut capacity doesn't match value: expected %v, got %v", round, value, capacity)
+		}
+
+		remaining, _, err := MinCutEdges(graph, 0, n-1)
+		if err != nil {
+			t.Fatalf("round %d: unexpected error: %s", round, err.Error())
+		}
+
+		if remaining != 0 {
+			t.Errorf("round %d: sink still reachable after removing the cut, remaining flow %v", round, remaining)
+		}
+	}
+}
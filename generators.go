@@ -0,0 +1,197 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// ConfigurationModel creates a random undirected graph whose vertex degrees follow the given
+// degree sequence. The graph has the vertices 0 to len(degrees)-1, and degrees[i] is the desired
+// degree of vertex i. The hash function determines the hashes of these vertices, e.g. IntHash.
+//
+// For each vertex, ConfigurationModel creates as many stubs (half-edges) as its desired degree and
+// then joins randomly chosen pairs of stubs. Because this library doesn't support multigraphs and
+// the pairing may produce self-loops, pairs that would create a self-loop or an edge that already
+// exists are discarded instead of being resampled. This is known as the erased configuration model.
+// The actual degrees may therefore be slightly smaller than the desired ones, which mostly matters
+// for small graphs and high degrees.
+//
+// The sum of all degrees must be even, since each edge contributes two stubs, and no degree may be
+// negative. Otherwise, an error will be returned.
+func ConfigurationModel(degrees []int, hash Hash[int, int], rng *rand.Rand) (Graph[int, int], error) {
+	stubs := make([]int, 0)
+
+	for vertex, degree := range degrees {
+		if degree < 0 {
+			return nil, fmt.Errorf("degree of vertex %d must not be negative, got %d", vertex, degree)
+		}
+
+		for i := 0; i < degree; i++ {
+			stubs = append(stubs, vertex)
+		}
+	}
+
+	if len(stubs)%2 != 0 {
+		return nil, fmt.Errorf("sum of degrees must be even, got %d", len(stubs))
+	}
+
+	g := New(hash)
+
+	for vertex := range degrees {
+		if err := g.AddVertex(vertex); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %d: %w", vertex, err)
+		}
+	}
+
+	rng.Shuffle(len(stubs), func(i, j int) {
+		stubs[i], stubs[j] = stubs[j], stubs[i]
+	})
+
+	for i := 0; i < len(stubs); i += 2 {
+		source, target := hash(stubs[i]), hash(stubs[i+1])
+
+		if source == target || g.HasEdge(source, target) {
+			continue
+		}
+
+		if err := g.AddEdge(source, target); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+		}
+	}
+
+	return g, nil
+}
+
+// BarabasiAlbert creates a random undirected scale-free graph with n vertices using the
+// Barabási-Albert preferential attachment model. The graph has the vertices 0 to n-1, whose hashes
+// are determined by the given hash function, e.g. IntHash.
+//
+// The graph starts with the m seed vertices 0 to m-1. Each subsequent vertex is joined with m
+// distinct existing vertices, which are chosen with a probability proportional to their degree.
+// The first non-seed vertex is joined with all seed vertices, so the resulting graph is connected
+// and has (n-m)*m edges. m must be at least 1 and smaller than n, otherwise an error is returned.
+func BarabasiAlbert(n, m int, hash Hash[int, int], rng *rand.Rand) (Graph[int, int], error) {
+	if m < 1 || m >= n {
+		return nil, fmt.Errorf("m must be at least 1 and smaller than n, got m=%d and n=%d", m, n)
+	}
+
+	g := New(hash)
+
+	for vertex := 0; vertex < m; vertex++ {
+		if err := g.AddVertex(vertex); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %d: %w", vertex, err)
+		}
+	}
+
+	targets := make([]int, m)
+	for i := range targets {
+		targets[i] = i
+	}
+
+	// Each vertex is contained in repeated once per incident edge, so drawing uniformly from
+	// repeated picks vertices proportionally to their degree.
+	repeated := make([]int, 0, 2*(n-m)*m)
+
+	for source := m; source < n; source++ {
+		if err := g.AddVertex(source); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %d: %w", source, err)
+		}
+
+		for _, target := range targets {
+			if err := g.AddEdge(hash(source), hash(target)); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+			}
+
+			repeated = append(repeated, source, target)
+		}
+
+		targets = targets[:0]
+		chosen := make(map[int]bool, m)
+
+		for len(targets) < m {
+			target := repeated[rng.Intn(len(repeated))]
+			if chosen[target] {
+				continue
+			}
+
+			chosen[target] = true
+			targets = append(targets, target)
+		}
+	}
+
+	return g, nil
+}
+
+// WattsStrogatz creates a random undirected small-world graph with n vertices using the
+// Watts-Strogatz model. The graph has the vertices 0 to n-1, whose hashes are determined by the
+// given hash function, e.g. IntHash.
+//
+// WattsStrogatz first builds a ring lattice in which each vertex is joined with its k nearest
+// neighbours, k/2 on each side. Then, each lattice edge (u, v) is rewired with probability beta by
+// replacing it with an edge (u, w), where w is chosen uniformly among all vertices that aren't
+// joined with u yet. Rewiring never creates self-loops or duplicate edges, so the graph always
+// has n*k/2 edges. A beta of 0 keeps the lattice, whereas a beta of 1 yields a random graph.
+//
+// k must be even, non-negative, and smaller than n, and beta must be between 0 and 1. Otherwise,
+// an error will be returned.
+func WattsStrogatz(n, k int, beta float64, hash Hash[int, int], rng *rand.Rand) (Graph[int, int], error) {
+	if k < 0 || k%2 != 0 || k >= n {
+		return nil, fmt.Errorf("k must be even, non-negative, and smaller than n, got k=%d and n=%d", k, n)
+	}
+
+	if beta < 0 || beta > 1 {
+		return nil, fmt.Errorf("beta must be between 0 and 1, got %v", beta)
+	}
+
+	g := New(hash)
+
+	for vertex := 0; vertex < n; vertex++ {
+		if err := g.AddVertex(vertex); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %d: %w", vertex, err)
+		}
+	}
+
+	degrees := make([]int, n)
+
+	for offset := 1; offset <= k/2; offset++ {
+		for u := 0; u < n; u++ {
+			v := (u + offset) % n
+
+			if err := g.AddEdge(hash(u), hash(v)); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", u, v, err)
+			}
+
+			degrees[u]++
+			degrees[v]++
+		}
+	}
+
+	for offset := 1; offset <= k/2; offset++ {
+		for u := 0; u < n; u++ {
+			// A vertex that is joined with all other vertices can't be rewired.
+			if rng.Float64() >= beta || degrees[u] >= n-1 {
+				continue
+			}
+
+			v := (u + offset) % n
+
+			w := rng.Intn(n)
+			for w == u || g.HasEdge(hash(u), hash(w)) {
+				w = rng.Intn(n)
+			}
+
+			if err := g.RemoveEdge(hash(u), hash(v)); err != nil {
+				return nil, fmt.Errorf("failed to remove edge (%v, %v): %w", u, v, err)
+			}
+
+			if err := g.AddEdge(hash(u), hash(w)); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", u, w, err)
+			}
+
+			degrees[v]--
+			degrees[w]++
+		}
+	}
+
+	return g, nil
+}
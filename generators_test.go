@@ -0,0 +1,229 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestConfigurationModel(t *testing.T) {
+	tests := map[string]struct {
+		degrees    []int
+		shouldFail bool
+	}{
+		"regular degree sequence": {
+			degrees: []int{3, 3, 3, 3, 3, 3, 3, 3},
+		},
+		"mixed degree sequence": {
+			degrees: []int{5, 1, 1, 2, 3, 2, 4, 1, 1, 2},
+		},
+		"isolated vertices": {
+			degrees: []int{0, 0, 0},
+		},
+		"empty degree sequence": {
+			degrees: []int{},
+		},
+		"odd degree sum": {
+			degrees:    []int{1, 2, 2},
+			shouldFail: true,
+		},
+		"negative degree": {
+			degrees:    []int{-1, 1},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph, err := ConfigurationModel(test.degrees, IntHash, rand.New(rand.NewSource(42)))
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if graph.Order() != len(test.degrees) {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, len(test.degrees), graph.Order())
+		}
+
+		adjacencyMap, _ := graph.AdjacencyMap()
+
+		for vertex, degree := range test.degrees {
+			if _, ok := adjacencyMap[vertex][vertex]; ok {
+				t.Errorf("%s: unexpected self-loop at vertex %v", name, vertex)
+			}
+
+			if len(adjacencyMap[vertex]) > degree {
+				t.Errorf("%s: degree of vertex %v exceeds the desired degree: expected at most %v, got %v", name, vertex, degree, len(adjacencyMap[vertex]))
+			}
+		}
+	}
+}
+
+func TestConfigurationModelIsReproducible(t *testing.T) {
+	degrees := []int{2, 2, 2, 2, 2, 2}
+
+	a, _ := ConfigurationModel(degrees, IntHash, rand.New(rand.NewSource(7)))
+	b, _ := ConfigurationModel(degrees, IntHash, rand.New(rand.NewSource(7)))
+
+	aEdges, _ := a.AdjacencyMap()
+	bEdges, _ := b.AdjacencyMap()
+
+	for vertex := range aEdges {
+		for adjacency := range aEdges[vertex] {
+			if _, ok := bEdges[vertex][adjacency]; !ok {
+				t.Errorf("edge (%v, %v) expected in both graphs", vertex, adjacency)
+			}
+		}
+	}
+}
+
+func TestBarabasiAlbert(t *testing.T) {
+	tests := map[string]struct {
+		n          int
+		m          int
+		shouldFail bool
+	}{
+		"single edge per vertex": {
+			n: 20,
+			m: 1,
+		},
+		"three edges per vertex": {
+			n: 50,
+			m: 3,
+		},
+		"m equals n-1": {
+			n: 4,
+			m: 3,
+		},
+		"m is zero": {
+			n:          10,
+			m:          0,
+			shouldFail: true,
+		},
+		"m equals n": {
+			n:          5,
+			m:          5,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph, err := BarabasiAlbert(test.n, test.m, IntHash, rand.New(rand.NewSource(42)))
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if graph.Traits().IsDirected {
+			t.Errorf("%s: graph is expected to be undirected", name)
+		}
+
+		if graph.Order() != test.n {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, test.n, graph.Order())
+		}
+
+		if expectedSize := (test.n - test.m) * test.m; graph.Size() != expectedSize {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, expectedSize, graph.Size())
+		}
+
+		visited := 0
+		_ = BFS(graph, 0, func(int) bool {
+			visited++
+			return false
+		})
+
+		if visited != test.n {
+			t.Errorf("%s: graph is expected to be connected, but only %v of %v vertices are reachable", name, visited, test.n)
+		}
+	}
+}
+
+func TestWattsStrogatz(t *testing.T) {
+	tests := map[string]struct {
+		n          int
+		k          int
+		beta       float64
+		shouldFail bool
+	}{
+		"ring lattice": {
+			n:    10,
+			k:    4,
+			beta: 0,
+		},
+		"small-world graph": {
+			n:    30,
+			k:    4,
+			beta: 0.2,
+		},
+		"random graph": {
+			n:    30,
+			k:    6,
+			beta: 1,
+		},
+		"nearly complete graph": {
+			n:    5,
+			k:    4,
+			beta: 1,
+		},
+		"odd k": {
+			n:          10,
+			k:          3,
+			shouldFail: true,
+		},
+		"k equals n": {
+			n:          4,
+			k:          4,
+			shouldFail: true,
+		},
+		"beta out of range": {
+			n:          10,
+			k:          2,
+			beta:       1.5,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph, err := WattsStrogatz(test.n, test.k, test.beta, IntHash, rand.New(rand.NewSource(42)))
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if graph.Order() != test.n {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, test.n, graph.Order())
+		}
+
+		if expectedSize := test.n * test.k / 2; graph.Size() != expectedSize {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, expectedSize, graph.Size())
+		}
+
+		for vertex := 0; vertex < test.n; vertex++ {
+			if graph.HasEdge(vertex, vertex) {
+				t.Errorf("%s: unexpected self-loop at vertex %v", name, vertex)
+			}
+		}
+
+		if test.beta != 0 {
+			continue
+		}
+
+		for vertex := 0; vertex < test.n; vertex++ {
+			for offset := 1; offset <= test.k/2; offset++ {
+				if !graph.HasEdge(vertex, (vertex+offset)%test.n) {
+					t.Errorf("%s: lattice edge (%v, %v) expected", name, vertex, (vertex+offset)%test.n)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+func TestGobEncodeDecode(t *testing.T) {
+	type city struct {
+		Name       string
+		Population int
+	}
+
+	cityHash := func(c city) string {
+		return c.Name
+	}
+
+	tests := map[string]struct {
+		traits   []func(*Traits)
+		vertices []city
+		edges    []Edge[string]
+	}{
+		"directed weighted graph with attributes": {
+			traits: []func(*Traits){Directed(), Acyclic(), Weighted()},
+			vertices: []city{
+				{Name: "London", Population: 9000000},
+				{Name: "Paris", Population: 2100000},
+				{Name: "Berlin", Population: 3600000},
+			},
+			edges: []Edge[string]{
+				{Source: "London", Target: "Paris", Properties: EdgeProperties{Weight: 344, Attributes: map[string]string{"mode": "train"}}},
+				{Source: "Paris", Target: "Berlin", Properties: EdgeProperties{Weight: 878}},
+			},
+		},
+		"undirected graph with a self-loop": {
+			traits: []func(*Traits){Rooted()},
+			vertices: []city{
+				{Name: "London"},
+				{Name: "Paris"},
+			},
+			edges: []Edge[string]{
+				{Source: "London", Target: "Paris"},
+				{Source: "Paris", Target: "Paris", Properties: EdgeProperties{Attributes: map[string]string{"kind": "loop"}}},
+			},
+		},
+		"empty graph": {},
+	}
+
+	for name, test := range tests {
+		graph := New(cityHash, test.traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			options := []func(*EdgeProperties){EdgeWeight(edge.Properties.Weight)}
+			for key, value := range edge.Properties.Attributes {
+				options = append(options, EdgeAttribute(key, value))
+			}
+
+			if err := graph.AddEdge(edge.Source, edge.Target, options...); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		var buf bytes.Buffer
+
+		if err := GobEncode(graph, &buf); err != nil {
+			t.Fatalf("%s: failed to encode graph: %s", name, err.Error())
+		}
+
+		restored, err := GobDecode(&buf, cityHash)
+		if err != nil {
+			t.Fatalf("%s: failed to decode graph: %s", name, err.Error())
+		}
+
+		if *restored.Traits() != *graph.Traits() {
+			t.Errorf("%s: traits expectancy doesn't match: expected %v, got %v", name, graph.Traits(), restored.Traits())
+		}
+
+		if restored.Order() != graph.Order() || restored.Size() != graph.Size() {
+			t.Fatalf("%s: order and size expectancy doesn't match: expected %v and %v, got %v and %v", name, graph.Order(), graph.Size(), restored.Order(), restored.Size())
+		}
+
+		for _, expectedVertex := range test.vertices {
+			vertex, err := restored.Vertex(expectedVertex.Name)
+			if err != nil {
+				t.Fatalf("%s: vertex %v expected: %s", name, expectedVertex.Name, err.Error())
+			}
+
+			if vertex != expectedVertex {
+				t.Errorf("%s: vertex expectancy doesn't match: expected %v, got %v", name, expectedVertex, vertex)
+			}
+		}
+
+		for _, expectedEdge := range test.edges {
+			edge, err := restored.Edge(expectedEdge.Source, expectedEdge.Target)
+			if err != nil {
+				t.Fatalf("%s: edge (%v, %v) expected: %s", name, expectedEdge.Source, expectedEdge.Target, err.Error())
+			}
+
+			if !propertiesAreEqual(edge.Properties, expectedEdge.Properties) {
+				t.Errorf("%s: edge properties expectancy doesn't match: expected %v, got %v", name, expectedEdge.Properties, edge.Properties)
+			}
+		}
+	}
+}
+
+func TestGobDecode_UnsupportedVersion(t *testing.T) {
+	var buf bytes.Buffer
+
+	serialized := serializedGraphV1[int, int]{Version: serializationVersion + 1}
+	if err := gob.NewEncoder(&buf).Encode(serialized); err != nil {
+		t.Fatalf("failed to encode graph: %s", err.Error())
+	}
+
+	if _, err := GobDecode(&buf, IntHash); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("error expectancy doesn't match: expected %v, got %v", ErrUnsupportedVersion, err)
+	}
+}
+
+func TestGobDecode_InvalidData(t *testing.T) {
+	if _, err := GobDecode(bytes.NewBufferString("not a gob stream"), IntHash); err == nil {
+		t.Error("error expectancy doesn't match: expected an error, got nil")
+	}
+}
@@ -1,9 +1,69 @@
 package graph
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
 
-// ErrEdgeNotFound will be returned when a desired edge cannot be found.
-var ErrEdgeNotFound = errors.New("edge not found")
+var (
+	// ErrEdgeNotFound will be returned when a desired edge cannot be found.
+	ErrEdgeNotFound = errors.New("edge not found")
+	// ErrTargetNotReachable will be returned when the target vertex of a path search cannot be
+	// reached from the source vertex.
+	ErrTargetNotReachable = errors.New("target vertex not reachable from source")
+	// ErrEdgeCreatesCycle will be returned when adding an edge to an acyclic graph would introduce
+	// a cycle. The returned error is a *CycleError, which wraps ErrEdgeCreatesCycle.
+	ErrEdgeCreatesCycle = errors.New("edge would create a cycle")
+	// ErrUnsupportedVersion will be returned by Unmarshal when the serialized graph has a format
+	// version that this version of the library doesn't know.
+	ErrUnsupportedVersion = errors.New("unsupported serialization format version")
+	// ErrReadOnly will be returned when attempting to modify a graph created using ReadOnly.
+	ErrReadOnly = errors.New("graph is read-only")
+	// ErrAcyclic will be returned by algorithms that search for a cycle if the graph doesn't
+	// contain any cycle.
+	ErrAcyclic = errors.New("graph doesn't contain a cycle")
+	// ErrNotBipartite will be returned by BipartiteChecker when an edge joins two vertices that are
+	// bound to the same partition. The returned error is a *BipartiteConflictError, which wraps
+	// ErrNotBipartite.
+	ErrNotBipartite = errors.New("graph is not bipartite")
+	// ErrInvalidTraits will be returned by NewChecked when the given traits can't be combined.
+	ErrInvalidTraits = errors.New("invalid combination of traits")
+)
+
+// CycleError will be returned by AddEdge when adding an edge between the source and the target
+// vertex would introduce a cycle in an acyclic graph. It provides the cycle that would be closed
+// by the edge, which helps with debugging cyclic dependencies.
+//
+// A CycleError wraps ErrEdgeCreatesCycle, so that it can be detected using errors.Is:
+//
+//	if errors.Is(err, graph.ErrEdgeCreatesCycle) {
+//		var cycleErr *graph.CycleError[string]
+//		if errors.As(err, &cycleErr) {
+//			fmt.Println(cycleErr.Cycle())
+//		}
+//	}
+type CycleError[K comparable] struct {
+	Source K
+	Target K
+	cycle  []K
+}
+
+func (c *CycleError[K]) Error() string {
+	return fmt.Sprintf("an edge between %v and %v would introduce a cycle: %v", c.Source, c.Target, c.cycle)
+}
+
+// Cycle returns the vertices of the cycle that the edge would close. The cycle starts with the
+// source vertex of the edge, followed by its target vertex and the path leading from the target
+// back to the source. For a self-loop, the cycle only consists of the source vertex.
+func (c *CycleError[K]) Cycle() []K {
+	return c.cycle
+}
+
+// Unwrap returns ErrEdgeCreatesCycle.
+func (c *CycleError[K]) Unwrap() error {
+	return ErrEdgeCreatesCycle
+}
 
 // Graph represents a generic graph data structure consisting of vertices and edges. Its vertices
 // are of type T, and each vertex is identified by a hash of type K.
@@ -21,6 +81,10 @@ type Graph[K comparable, T any] interface {
 	// Vertex returns the vertex with the given hash or an error if the vertex doesn't exist.
 	Vertex(hash K) (T, error)
 
+	// HasVertex checks whether the graph contains a vertex with the given hash. Unlike Vertex, it
+	// doesn't construct an error value if the vertex doesn't exist.
+	HasVertex(hash K) bool
+
 	// AddEdge creates an edge between the source and the target vertex. If the Directed option has
 	// been called on the graph, this is a directed edge. Returns an error if either vertex doesn't
 	// exist or the edge already exists.
@@ -36,10 +100,27 @@ type Graph[K comparable, T any] interface {
 	// undirected graph, an edge with swapped source and target vertices does match.
 	Edge(sourceHash, targetHash K) (Edge[T], error)
 
+	// HasEdge checks whether the graph contains an edge joining the given vertices. Unlike Edge,
+	// it doesn't construct an error value if the edge doesn't exist. In an undirected graph, an
+	// edge with swapped source and target vertices does match.
+	HasEdge(sourceHash, targetHash K) bool
+
+	// LookupEdge returns the edge joining two given vertices and whether that edge exists, just like
+	// a map lookup. Unlike Edge, it doesn't construct an error value if the edge doesn't exist. In an
+	// undirected graph, an edge with swapped source and target vertices does match.
+	LookupEdge(sourceHash, targetHash K) (Edge[T], bool)
+
 	// RemoveEdge removes the edge between the given source and target vertices. If the edge doesn't
 	// exist, ErrEdgeNotFound will be returned.
 	RemoveEdge(source, target K) error
 
+	// MapEdgeWeights replaces the weight of each edge in the graph with the weight returned by f,
+	// which is called with the source and target vertex hashes as well as the current weight of
+	// the edge. In an undirected graph, f is called once per edge. This is a convenient way to
+	// reweight or normalize all edges at once. Calling MapEdgeWeights on a graph without any
+	// edges does nothing.
+	MapEdgeWeights(f func(source, target K, weight int) int) error
+
 	// AdjacencyMap computes and returns an adjacency map containing all vertices in the graph.
 	//
 	// There is an entry for each vertex, and each of those entries is another map whose keys are
@@ -55,7 +136,10 @@ type Graph[K comparable, T any] interface {
 	//		}
 	//	}
 	//
-	// This design makes AdjacencyMap suitable for a wide variety of scenarios and demands.
+	// This design makes AdjacencyMap suitable for a wide variety of scenarios and demands. Since
+	// there is only one entry per pair of vertices, the adjacency map cannot contain parallel
+	// edges, and weighted algorithms always see exactly one weight between two vertices. To
+	// collapse parallel edges from multiple sources, use Merge with MinWeight or SumWeights.
 	AdjacencyMap() (map[K]map[K]Edge[K], error)
 
 	// PredecessorMap computes and returns a predecessors map containing all vertices in the graph.
@@ -68,13 +152,29 @@ type Graph[K comparable, T any] interface {
 	// predecessors are the vertices joined by an ingoing edge.
 	PredecessorMap() (map[K]map[K]Edge[K], error)
 
-	// Clone creates an independent deep copy of the graph and returns that cloned graph.
+	// PredecessorCount returns the number of predecessors of the vertex with the given hash, which
+	// is the in-degree of the vertex in a directed graph. Unlike PredecessorMap, it doesn't build
+	// any intermediate data structures. In an undirected graph, it returns the number of adjacent
+	// vertices. If the vertex doesn't exist, an error will be returned.
+	PredecessorCount(hash K) (int, error)
+
+	// SuccessorCount returns the number of successors of the vertex with the given hash, which is
+	// the out-degree of the vertex in a directed graph. Unlike AdjacencyMap, it doesn't build any
+	// intermediate data structures. In an undirected graph, it returns the number of adjacent
+	// vertices. If the vertex doesn't exist, an error will be returned.
+	SuccessorCount(hash K) (int, error)
+
+	// Clone creates an independent deep copy of the graph and returns that cloned graph. The clone
+	// keeps the insertion order of the vertices and edges, see VerticesInInsertionOrder.
 	Clone() (Graph[K, T], error)
 
-	// Order computes and returns the number of vertices in the graph.
+	// Order returns the number of vertices in the graph. For the graphs created by New, this is a
+	// constant-time operation.
 	Order() int
 
-	// Size computes and returns the number of edges in the graph.
+	// Size returns the number of edges in the graph. A self-loop counts as a single edge. For the
+	// graphs created by New, the number of edges is maintained as edges are added and removed, so
+	// this is a constant-time operation.
 	Size() int
 }
 
@@ -93,11 +193,63 @@ type Edge[T any] struct {
 //	g.Edge("A", "B", graph.EdgeWeight(2), graph.EdgeAttribute("color", "red"))
 //
 // The example above will create an edge with weight 2 and a "color" attribute with value "red".
+//
+// Weights are stored as integers. For weights of other numeric types, such as decimal costs, see
+// Number and the algorithms accepting a weight function, e.g. ShortestPathByWeight.
 type EdgeProperties struct {
 	Attributes map[string]string
 	Weight     int
 }
 
+// AttributeInt returns the value of the attribute with the given key parsed as an integer. If the
+// attribute doesn't exist or isn't a valid integer, 0 and false will be returned.
+func (e EdgeProperties) AttributeInt(key string) (int, bool) {
+	value, ok := e.Attributes[key]
+	if !ok {
+		return 0, false
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return parsed, true
+}
+
+// AttributeFloat returns the value of the attribute with the given key parsed as a float64. If the
+// attribute doesn't exist or isn't a valid float, 0 and false will be returned.
+func (e EdgeProperties) AttributeFloat(key string) (float64, bool) {
+	value, ok := e.Attributes[key]
+	if !ok {
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return parsed, true
+}
+
+// AttributeBool returns the value of the attribute with the given key parsed as a boolean. Valid
+// values are those accepted by strconv.ParseBool, such as "true", "false", "1", and "0". If the
+// attribute doesn't exist or isn't a valid boolean, false and false will be returned.
+func (e EdgeProperties) AttributeBool(key string) (bool, bool) {
+	value, ok := e.Attributes[key]
+	if !ok {
+		return false, false
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, false
+	}
+
+	return parsed, true
+}
+
 // Hash is a hashing function that takes a vertex of type T and returns a hash value of type K.
 //
 // Every graph has a hashing function and uses that function to retrieve the hash values of its
@@ -160,6 +312,62 @@ func New[K comparable, T any](hash Hash[K, T], options ...func(*Traits)) Graph[K
 	return newUndirected(hash, &p)
 }
 
+// NewChecked creates a new graph just like New does, but validates the combination of the given
+// traits first. If the traits can't be combined, an error wrapping ErrInvalidTraits is returned
+// instead of a graph. See Traits for the supported combinations.
+//
+//	g, err := graph.NewChecked(graph.IntHash, graph.Rooted())
+//	if errors.Is(err, graph.ErrInvalidTraits) {
+//		// A rooted graph has to be acyclic, e.g. created using graph.Tree().
+//	}
+func NewChecked[K comparable, T any](hash Hash[K, T], options ...func(*Traits)) (Graph[K, T], error) {
+	var p Traits
+
+	for _, option := range options {
+		option(&p)
+	}
+
+	if err := validateTraits(p); err != nil {
+		return nil, err
+	}
+
+	return New(hash, options...), nil
+}
+
+// newLike creates a new, empty graph that has the same hashing function and the same traits as
+// the given graph. The traits are copied, so that the new graph has its own instance. This only
+// works for graphs created using New.
+func newLike[K comparable, T any](g Graph[K, T]) (Graph[K, T], error) {
+	traits := *g.Traits()
+
+	switch typedGraph := g.(type) {
+	case *directed[K, T]:
+		return newDirected(typedGraph.hash, &traits), nil
+	case *undirected[K, T]:
+		return newUndirected(typedGraph.hash, &traits), nil
+	case *readOnly[K, T]:
+		return newLike(typedGraph.g)
+	}
+
+	return nil, fmt.Errorf("unsupported graph implementation %T", g)
+}
+
+// hashFunc returns the hashing function of the given graph, which is required to determine the hash
+// of a vertex value before adding it to the graph. For graph implementations other than the ones
+// provided by this package, the hashing function is unknown and hashFunc returns false.
+func hashFunc[K comparable, T any](g Graph[K, T]) (Hash[K, T], bool) {
+	switch typedGraph := g.(type) {
+	case *directed[K, T]:
+		return typedGraph.hash, true
+	case *undirected[K, T]:
+		return typedGraph.hash, true
+	case *readOnly[K, T]:
+		return hashFunc(typedGraph.g)
+	}
+
+	return nil, false
+}
+
 // StringHash is a hashing function that accepts a string and uses that exact string as a hash
 // value. Using it as Hash will yield a Graph[string, string].
 func StringHash(v string) string {
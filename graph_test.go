@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -33,6 +34,57 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewChecked(t *testing.T) {
+	tests := map[string]struct {
+		options    []func(*Traits)
+		expected   Traits
+		shouldFail bool
+	}{
+		"no options": {
+			options: []func(*Traits){},
+		},
+		"directed acyclic graph": {
+			options:  []func(*Traits){Directed(), Acyclic()},
+			expected: Traits{IsDirected: true, IsAcyclic: true},
+		},
+		"undirected tree": {
+			options:  []func(*Traits){Tree(), Weighted()},
+			expected: Traits{IsAcyclic: true, IsRooted: true, IsWeighted: true},
+		},
+		"directed tree": {
+			options:  []func(*Traits){Directed(), Rooted(), Acyclic()},
+			expected: Traits{IsDirected: true, IsAcyclic: true, IsRooted: true},
+		},
+		"rooted graph without acyclic trait": {
+			options:    []func(*Traits){Rooted()},
+			shouldFail: true,
+		},
+		"directed rooted graph without acyclic trait": {
+			options:    []func(*Traits){Directed(), Rooted()},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph, err := NewChecked(IntHash, test.options...)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			if !errors.Is(err, ErrInvalidTraits) {
+				t.Errorf("%s: error expectancy doesn't match: expected %v, got %v", name, ErrInvalidTraits, err)
+			}
+			continue
+		}
+
+		if !traitsAreEqual(graph.Traits(), &test.expected) {
+			t.Errorf("%s: trait expectation doesn't match: expected %v, got %v", name, test.expected, *graph.Traits())
+		}
+	}
+}
+
 func TestStringHash(t *testing.T) {
 	tests := map[string]struct {
 		value        string
@@ -133,3 +185,73 @@ func TestEdgeAttribute(t *testing.T) {
 		}
 	}
 }
+
+func TestEdgeProperties_TypedAttributes(t *testing.T) {
+	tests := map[string]struct {
+		attributes    map[string]string
+		key           string
+		expectedInt   int
+		expectedFloat float64
+		expectedBool  bool
+		expectedOk    [3]bool
+	}{
+		"integer attribute": {
+			attributes:    map[string]string{"capacity": "42"},
+			key:           "capacity",
+			expectedInt:   42,
+			expectedFloat: 42,
+			expectedOk:    [3]bool{true, true, false},
+		},
+		"float attribute": {
+			attributes:    map[string]string{"latency": "1.5"},
+			key:           "latency",
+			expectedFloat: 1.5,
+			expectedOk:    [3]bool{false, true, false},
+		},
+		"boolean attribute": {
+			attributes:   map[string]string{"enabled": "true"},
+			key:          "enabled",
+			expectedBool: true,
+			expectedOk:   [3]bool{false, false, true},
+		},
+		"numeric boolean attribute": {
+			attributes:    map[string]string{"enabled": "1"},
+			key:           "enabled",
+			expectedInt:   1,
+			expectedFloat: 1,
+			expectedBool:  true,
+			expectedOk:    [3]bool{true, true, true},
+		},
+		"missing attribute": {
+			attributes: map[string]string{},
+			key:        "capacity",
+			expectedOk: [3]bool{false, false, false},
+		},
+		"nil attributes": {
+			key:        "capacity",
+			expectedOk: [3]bool{false, false, false},
+		},
+	}
+
+	for name, test := range tests {
+		properties := EdgeProperties{
+			Attributes: test.attributes,
+		}
+
+		intValue, intOk := properties.AttributeInt(test.key)
+		floatValue, floatOk := properties.AttributeFloat(test.key)
+		boolValue, boolOk := properties.AttributeBool(test.key)
+
+		if intValue != test.expectedInt || intOk != test.expectedOk[0] {
+			t.Errorf("%s: int expectation doesn't match: expected %v, %v, got %v, %v", name, test.expectedInt, test.expectedOk[0], intValue, intOk)
+		}
+
+		if floatValue != test.expectedFloat || floatOk != test.expectedOk[1] {
+			t.Errorf("%s: float expectation doesn't match: expected %v, %v, got %v, %v", name, test.expectedFloat, test.expectedOk[1], floatValue, floatOk)
+		}
+
+		if boolValue != test.expectedBool || boolOk != test.expectedOk[2] {
+			t.Errorf("%s: bool expectation doesn't match: expected %v, %v, got %v, %v", name, test.expectedBool, test.expectedOk[2], boolValue, boolOk)
+		}
+	}
+}
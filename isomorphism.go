@@ -0,0 +1,383 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// SubgraphMatches finds all occurrences of the pattern graph within the target graph. Each
+// occurrence is returned as a mapping from the pattern vertex hashes to the target vertex hashes.
+//
+// A mapping is a match if it maps distinct pattern vertices to distinct target vertices and if
+// there is a corresponding target edge for each pattern edge. The target graph may contain
+// additional edges between the matched vertices, i.e. the pattern doesn't need to be an induced
+// subgraph. If match is not nil, it additionally has to return true for each pattern vertex and
+// the target vertex it is mapped to. Both graphs have to be either directed or undirected.
+//
+// Symmetric patterns match the same set of target vertices multiple times. For example, a triangle
+// pattern yields 6 matches for each triangle in the target graph, one for each automorphism.
+//
+// Subgraph isomorphism is NP-complete, and SubgraphMatches uses backtracking that takes
+// exponential time in the worst case. Use SubgraphMatchesContext to be able to cancel the search.
+func SubgraphMatches[K1, K2 comparable, T1, T2 any](pattern Graph[K1, T1], target Graph[K2, T2], match func(T1, T2) bool) ([]map[K1]K2, error) {
+	return SubgraphMatchesContext(context.Background(), pattern, target, match)
+}
+
+// SubgraphMatchesContext works like SubgraphMatches, but stops the search and returns the
+// context's error as soon as the given context is canceled.
+func SubgraphMatchesContext[K1, K2 comparable, T1, T2 any](ctx context.Context, pattern Graph[K1, T1], target Graph[K2, T2], match func(T1, T2) bool) ([]map[K1]K2, error) {
+	if pattern.Traits().IsDirected != target.Traits().IsDirected {
+		return nil, errors.New("pattern and target graph must both be either directed or undirected")
+	}
+
+	patternAdjacencyMap, err := pattern.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map of pattern: %w", err)
+	}
+
+	targetAdjacencyMap, err := target.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map of target: %w", err)
+	}
+
+	patternDegrees := degrees(patternAdjacencyMap)
+	targetDegrees := degrees(targetAdjacencyMap)
+
+	patternVertices := matchingOrder(patternAdjacencyMap, patternDegrees)
+
+	targetVertices := make([]K2, 0, len(targetAdjacencyMap))
+	for vertex := range targetAdjacencyMap {
+		targetVertices = append(targetVertices, vertex)
+	}
+
+	sortKeys(targetVertices)
+
+	patternValues := make(map[K1]T1, len(patternVertices))
+	targetValues := make(map[K2]T2, len(targetVertices))
+
+	if match != nil {
+		for _, vertex := range patternVertices {
+			if patternValues[vertex], err = pattern.Vertex(vertex); err != nil {
+				return nil, fmt.Errorf("could not get pattern vertex %v: %w", vertex, err)
+			}
+		}
+
+		for _, vertex := range targetVertices {
+			if targetValues[vertex], err = target.Vertex(vertex); err != nil {
+				return nil, fmt.Errorf("could not get target vertex %v: %w", vertex, err)
+			}
+		}
+	}
+
+	mapping := make(map[K1]K2, len(patternVertices))
+	used := make(map[K2]bool, len(patternVertices))
+	matches := make([]map[K1]K2, 0)
+
+	// isFeasible checks whether the pattern vertex can be mapped to the target vertex, given the
+	// vertices that have been mapped so far.
+	isFeasible := func(patternVertex K1, targetVertex K2) bool {
+		if used[targetVertex] {
+			return false
+		}
+
+		if patternDegrees[patternVertex] > targetDegrees[targetVertex] {
+			return false
+		}
+
+		if match != nil && !match(patternValues[patternVertex], targetValues[targetVertex]) {
+			return false
+		}
+
+		if _, ok := patternAdjacencyMap[patternVertex][patternVertex]; ok {
+			if _, ok := targetAdjacencyMap[targetVertex][targetVertex]; !ok {
+				return false
+			}
+		}
+
+		for mappedPattern, mappedTarget := range mapping {
+			if _, ok := patternAdjacencyMap[patternVertex][mappedPattern]; ok {
+				if _, ok := targetAdjacencyMap[targetVertex][mappedTarget]; !ok {
+					return false
+				}
+			}
+
+			if _, ok := patternAdjacencyMap[mappedPattern][patternVertex]; ok {
+				if _, ok := targetAdjacencyMap[mappedTarget][targetVertex]; !ok {
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+
+	var extend func(index int) error
+
+	extend = func(index int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if index == len(patternVertices) {
+			result := make(map[K1]K2, len(mapping))
+			for patternVertex, targetVertex := range mapping {
+				result[patternVertex] = targetVertex
+			}
+			matches = append(matches, result)
+			return nil
+		}
+
+		patternVertex := patternVertices[index]
+
+		for _, targetVertex := range targetVertices {
+			if !isFeasible(patternVertex, targetVertex) {
+				continue
+			}
+
+			mapping[patternVertex] = targetVertex
+			used[targetVertex] = true
+
+			if err := extend(index + 1); err != nil {
+				return err
+			}
+
+			delete(mapping, patternVertex)
+			delete(used, targetVertex)
+		}
+
+		return nil
+	}
+
+	if err := extend(0); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// degrees returns the number of edges incident to each vertex, counting incoming as well as
+// outgoing edges in a directed graph.
+func degrees[K comparable](adjacencyMap map[K]map[K]Edge[K]) map[K]int {
+	degrees := make(map[K]int, len(adjacencyMap))
+
+	for vertex, adjacencies := range adjacencyMap {
+		for adjacency := range adjacencies {
+			degrees[vertex]++
+			if adjacency != vertex {
+				degrees[adjacency]++
+			}
+		}
+	}
+
+	return degrees
+}
+
+// matchingOrder determines the order in which the pattern vertices are mapped. Each vertex is
+// chosen so that it has as many neighbours among the previously chosen vertices as possible, which
+// allows the search to detect mismatching edges early.
+func matchingOrder[K comparable](adjacencyMap map[K]map[K]Edge[K], degrees map[K]int) []K {
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sortKeys(vertices)
+
+	connections := make(map[K]int, len(vertices))
+	chosen := make(map[K]bool, len(vertices))
+	order := make([]K, 0, len(vertices))
+
+	for len(order) < len(vertices) {
+		var best K
+		isFirst := true
+
+		for _, vertex := range vertices {
+			if chosen[vertex] {
+				continue
+			}
+
+			if isFirst || connections[vertex] > connections[best] ||
+				connections[vertex] == connections[best] && degrees[vertex] > degrees[best] {
+				best = vertex
+				isFirst = false
+			}
+		}
+
+		chosen[best] = true
+		order = append(order, best)
+
+		for vertex, adjacencies := range adjacencyMap {
+			if _, ok := adjacencies[best]; ok {
+				connections[vertex]++
+			}
+		}
+
+		for adjacency := range adjacencyMap[best] {
+			if _, ok := adjacencyMap[adjacency][best]; !ok {
+				connections[adjacency]++
+			}
+		}
+	}
+
+	return order
+}
+
+// EditCosts defines the costs of the edit operations used by EditDistanceApprox. A substitution
+// maps a vertex or an edge of the first graph to a vertex or an edge of the second graph. Vertex
+// substitutions only have a cost if the hashes of both vertices differ, and edge substitutions
+// only have a cost if the weights of both edges differ.
+type EditCosts struct {
+	VertexInsertion    int
+	VertexDeletion     int
+	VertexSubstitution int
+	EdgeInsertion      int
+	EdgeDeletion       int
+	EdgeSubstitution   int
+}
+
+// EditDistanceApprox estimates the graph edit distance between the graphs a and b, which is the
+// minimum total cost of vertex and edge insertions, deletions, and substitutions that transform a
+// into b. Both graphs must have the same directedness.
+//
+// Computing the exact graph edit distance is NP-hard. EditDistanceApprox uses the bipartite
+// heuristic by Riesen and Bunke instead: It assigns the vertices of a to the vertices of b by
+// solving an assignment problem whose costs take the vertex costs and the number of incident
+// edges into account. The returned value is the exact cost of the edit path induced by that
+// assignment, so it is never smaller than the exact distance. If both graphs are equal and vertex
+// substitutions have a positive cost, the returned value is 0. This takes O((|V_a|+|V_b|)^3) time.
+func EditDistanceApprox[K comparable, T any](a, b Graph[K, T], costs EditCosts) (int, error) {
+	if a.Traits().IsDirected != b.Traits().IsDirected {
+		return 0, errors.New("edit distance can only be computed for graphs with the same directedness")
+	}
+
+	isDirected := a.Traits().IsDirected
+
+	aAdjacencyMap, err := a.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	bAdjacencyMap, err := b.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	aVertices, bVertices := sortedMapKeys(aAdjacencyMap), sortedMapKeys(bAdjacencyMap)
+	aEdges, bEdges := sortedEdges(aAdjacencyMap, isDirected), sortedEdges(bAdjacencyMap, isDirected)
+	aDegrees, bDegrees := incidentEdgeCounts(aEdges), incidentEdgeCounts(bEdges)
+
+	n, m := len(aVertices), len(bVertices)
+
+	// Entries that must not be chosen get a cost greater than any complete assignment.
+	forbidden := 1
+	for _, cost := range []int{costs.VertexInsertion, costs.VertexDeletion, costs.VertexSubstitution} {
+		forbidden += (n + m) * absInt(cost)
+	}
+	for _, cost := range []int{costs.EdgeInsertion, costs.EdgeDeletion} {
+		forbidden += (n + m) * (len(aEdges) + len(bEdges)) * absInt(cost)
+	}
+
+	// The cost matrix consists of four blocks: substitutions in the upper left, deletions in the
+	// upper right, insertions in the lower left, and zeros in the lower right.
+	weights := make([][]int, n+m)
+
+	for i := range weights {
+		weights[i] = make([]int, n+m)
+
+		for j := range weights[i] {
+			cost := 0
+
+			switch {
+			case i < n && j < m:
+				if aVertices[i] != bVertices[j] {
+					cost += costs.VertexSubstitution
+				}
+				if difference := aDegrees[aVertices[i]] - bDegrees[bVertices[j]]; difference > 0 {
+					cost += difference * costs.EdgeDeletion
+				} else {
+					cost -= difference * costs.EdgeInsertion
+				}
+			case i < n:
+				cost = forbidden
+				if j-m == i {
+					cost = costs.VertexDeletion + aDegrees[aVertices[i]]*costs.EdgeDeletion
+				}
+			case j < m:
+				cost = forbidden
+				if i-n == j {
+					cost = costs.VertexInsertion + bDegrees[bVertices[j]]*costs.EdgeInsertion
+				}
+			}
+
+			// The assignment maximizes the weights, so the costs are negated.
+			weights[i][j] = -cost
+		}
+	}
+
+	assignment := hungarian(weights)
+
+	mapping := make(map[K]K, n)
+	mapped := make(map[K]bool, m)
+	distance := 0
+
+	for i := 0; i < n; i++ {
+		if j := assignment[i]; j < m {
+			mapping[aVertices[i]] = bVertices[j]
+			mapped[bVertices[j]] = true
+			if aVertices[i] != bVertices[j] {
+				distance += costs.VertexSubstitution
+			}
+		} else {
+			distance += costs.VertexDeletion
+		}
+	}
+
+	distance += (m - len(mapped)) * costs.VertexInsertion
+
+	// With the vertex mapping in place, each edge of a is either substituted by the edge between
+	// the mapped vertices in b or deleted. All remaining edges of b have to be inserted.
+	substituted := 0
+
+	for _, edge := range aEdges {
+		source, sourceOk := mapping[edge.Source]
+		target, targetOk := mapping[edge.Target]
+
+		bEdge, ok := bAdjacencyMap[source][target]
+		if !sourceOk || !targetOk || !ok {
+			distance += costs.EdgeDeletion
+			continue
+		}
+
+		substituted++
+		if edge.Properties.Weight != bEdge.Properties.Weight {
+			distance += costs.EdgeSubstitution
+		}
+	}
+
+	distance += (len(bEdges) - substituted) * costs.EdgeInsertion
+
+	return distance, nil
+}
+
+// incidentEdgeCounts returns the number of edges incident to each vertex.
+func incidentEdgeCounts[K comparable](edges []Edge[K]) map[K]int {
+	counts := make(map[K]int)
+
+	for _, edge := range edges {
+		counts[edge.Source]++
+		if edge.Target != edge.Source {
+			counts[edge.Target]++
+		}
+	}
+
+	return counts
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
@@ -0,0 +1,351 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSubgraphMatches(t *testing.T) {
+	tests := map[string]struct {
+		patternIsDirected bool
+		patternVertices   []string
+		patternEdges      []Edge[string]
+		targetIsDirected  bool
+		targetVertices    []int
+		targetEdges       []Edge[int]
+		match             func(string, int) bool
+		expectedMatches   []map[string]int
+		expectedCount     int
+		shouldFail        bool
+	}{
+		"triangle in complete graph": {
+			patternVertices: []string{"a", "b", "c"},
+			patternEdges: []Edge[string]{
+				{Source: "a", Target: "b"},
+				{Source: "b", Target: "c"},
+				{Source: "c", Target: "a"},
+			},
+			targetVertices: []int{1, 2, 3, 4},
+			targetEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expectedCount: 24,
+		},
+		"directed path in directed cycle": {
+			patternIsDirected: true,
+			patternVertices:   []string{"a", "b"},
+			patternEdges: []Edge[string]{
+				{Source: "a", Target: "b"},
+			},
+			targetIsDirected: true,
+			targetVertices:   []int{1, 2, 3},
+			targetEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedMatches: []map[string]int{
+				{"a": 1, "b": 2},
+				{"a": 2, "b": 3},
+				{"a": 3, "b": 1},
+			},
+			expectedCount: 3,
+		},
+		"vertex predicate": {
+			patternVertices: []string{"a", "b"},
+			patternEdges: []Edge[string]{
+				{Source: "a", Target: "b"},
+			},
+			targetVertices: []int{1, 2, 3},
+			targetEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			match: func(p string, t int) bool {
+				return p != "a" || t == 2
+			},
+			expectedMatches: []map[string]int{
+				{"a": 2, "b": 1},
+				{"a": 2, "b": 3},
+			},
+			expectedCount: 2,
+		},
+		"self-loop": {
+			patternIsDirected: true,
+			patternVertices:   []string{"a"},
+			patternEdges: []Edge[string]{
+				{Source: "a", Target: "a"},
+			},
+			targetIsDirected: true,
+			targetVertices:   []int{1, 2},
+			targetEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 2},
+			},
+			expectedMatches: []map[string]int{
+				{"a": 2},
+			},
+			expectedCount: 1,
+		},
+		"pattern larger than target": {
+			patternVertices: []string{"a", "b", "c"},
+			patternEdges: []Edge[string]{
+				{Source: "a", Target: "b"},
+				{Source: "b", Target: "c"},
+			},
+			targetVertices: []int{1, 2},
+			targetEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedCount: 0,
+		},
+		"mixed directedness": {
+			patternIsDirected: true,
+			patternVertices:   []string{"a"},
+			targetVertices:    []int{1},
+			shouldFail:        true,
+		},
+	}
+
+	for name, test := range tests {
+		var pattern Graph[string, string]
+		if test.patternIsDirected {
+			pattern = New(StringHash, Directed())
+		} else {
+			pattern = New(StringHash)
+		}
+
+		for _, vertex := range test.patternVertices {
+			_ = pattern.AddVertex(vertex)
+		}
+
+		for _, edge := range test.patternEdges {
+			if err := pattern.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		var target Graph[int, int]
+		if test.targetIsDirected {
+			target = New(IntHash, Directed())
+		} else {
+			target = New(IntHash)
+		}
+
+		for _, vertex := range test.targetVertices {
+			_ = target.AddVertex(vertex)
+		}
+
+		for _, edge := range test.targetEdges {
+			if err := target.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		matches, err := SubgraphMatches(pattern, target, test.match)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(matches) != test.expectedCount {
+			t.Errorf("%s: match count expectancy doesn't match: expected %v, got %v", name, test.expectedCount, len(matches))
+		}
+
+		for _, expected := range test.expectedMatches {
+			found := false
+
+			for _, actual := range matches {
+				if mappingsAreEqual(expected, actual) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				t.Errorf("%s: expected match %v not found in %v", name, expected, matches)
+			}
+		}
+
+		for _, actual := range matches {
+			for _, edge := range test.patternEdges {
+				if _, err := target.Edge(actual[edge.Source], actual[edge.Target]); err != nil {
+					t.Errorf("%s: pattern edge %v-%v is not preserved by %v", name, edge.Source, edge.Target, actual)
+				}
+			}
+		}
+	}
+}
+
+func TestSubgraphMatchesContext(t *testing.T) {
+	pattern := New(StringHash)
+	_ = pattern.AddVertex("a")
+
+	target := New(IntHash)
+	_ = target.AddVertex(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := SubgraphMatchesContext[string, int, string, int](ctx, pattern, target, nil)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error expectancy doesn't match: expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func mappingsAreEqual(a, b map[string]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, value := range a {
+		if otherValue, ok := b[key]; !ok || otherValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestEditDistanceApprox(t *testing.T) {
+	unitCosts := EditCosts{
+		VertexInsertion:    1,
+		VertexDeletion:     1,
+		VertexSubstitution: 1,
+		EdgeInsertion:      1,
+		EdgeDeletion:       1,
+		EdgeSubstitution:   1,
+	}
+
+	tests := map[string]struct {
+		aIsDirected      bool
+		bIsDirected      bool
+		aVertices        []int
+		aEdges           []Edge[int]
+		bVertices        []int
+		bEdges           []Edge[int]
+		costs            EditCosts
+		expectedDistance int
+		shouldFail       bool
+	}{
+		"equal graphs": {
+			aVertices:        []int{1, 2, 3},
+			aEdges:           []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}},
+			bVertices:        []int{1, 2, 3},
+			bEdges:           []Edge[int]{{Source: 2, Target: 1}, {Source: 3, Target: 2}},
+			costs:            unitCosts,
+			expectedDistance: 0,
+		},
+		"inserted edge": {
+			aVertices:        []int{1, 2, 3},
+			aEdges:           []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}},
+			bVertices:        []int{1, 2, 3},
+			bEdges:           []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}, {Source: 3, Target: 1}},
+			costs:            unitCosts,
+			expectedDistance: 1,
+		},
+		"deleted vertex with edge": {
+			aVertices:        []int{1, 2, 3},
+			aEdges:           []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}},
+			bVertices:        []int{1, 2},
+			bEdges:           []Edge[int]{{Source: 1, Target: 2}},
+			costs:            unitCosts,
+			expectedDistance: 2,
+		},
+		"substituted edge weight": {
+			aVertices:        []int{1, 2},
+			aEdges:           []Edge[int]{{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}}},
+			bVertices:        []int{1, 2},
+			bEdges:           []Edge[int]{{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}}},
+			costs:            unitCosts,
+			expectedDistance: 1,
+		},
+		"renamed vertex with expensive insertions": {
+			aVertices: []int{1, 2},
+			aEdges:    []Edge[int]{{Source: 1, Target: 2}},
+			bVertices: []int{1, 3},
+			bEdges:    []Edge[int]{{Source: 1, Target: 3}},
+			costs: EditCosts{
+				VertexInsertion:    10,
+				VertexDeletion:     10,
+				VertexSubstitution: 1,
+				EdgeInsertion:      10,
+				EdgeDeletion:       10,
+				EdgeSubstitution:   1,
+			},
+			expectedDistance: 1,
+		},
+		"directed graphs with reversed edge": {
+			aIsDirected:      true,
+			bIsDirected:      true,
+			aVertices:        []int{1, 2},
+			aEdges:           []Edge[int]{{Source: 1, Target: 2}},
+			bVertices:        []int{1, 2},
+			bEdges:           []Edge[int]{{Source: 2, Target: 1}},
+			costs:            unitCosts,
+			expectedDistance: 2,
+		},
+		"empty and non-empty graph": {
+			bVertices:        []int{1, 2},
+			bEdges:           []Edge[int]{{Source: 1, Target: 2}},
+			costs:            unitCosts,
+			expectedDistance: 3,
+		},
+		"graphs with different directedness": {
+			aIsDirected: true,
+			costs:       unitCosts,
+			shouldFail:  true,
+		},
+	}
+
+	for name, test := range tests {
+		newGraph := func(isDirected bool, vertices []int, edges []Edge[int]) Graph[int, int] {
+			var g Graph[int, int]
+			if isDirected {
+				g = New(IntHash, Directed(), Weighted())
+			} else {
+				g = New(IntHash, Weighted())
+			}
+
+			for _, vertex := range vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range edges {
+				if err := g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+					t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+				}
+			}
+
+			return g
+		}
+
+		a := newGraph(test.aIsDirected, test.aVertices, test.aEdges)
+		b := newGraph(test.bIsDirected, test.bVertices, test.bEdges)
+
+		distance, err := EditDistanceApprox(a, b, test.costs)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if distance != test.expectedDistance {
+			t.Errorf("%s: distance expectancy doesn't match: expected %v, got %v", name, test.expectedDistance, distance)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// serializationVersion is the format version written by Marshal. It has to be incremented whenever
+// the serialization format changes, and Unmarshal has to keep supporting all previous versions.
+const serializationVersion = 1
+
+// serializedGraphV1 is version 1 of the serialization format.
+type serializedGraphV1[K comparable, T any] struct {
+	Version  int                   `json:"version"`
+	Traits   serializedTraitsV1    `json:"traits"`
+	Vertices []T                   `json:"vertices"`
+	Edges    []serializedEdgeV1[K] `json:"edges"`
+}
+
+type serializedTraitsV1 struct {
+	IsDirected bool `json:"directed"`
+	IsAcyclic  bool `json:"acyclic"`
+	IsWeighted bool `json:"weighted"`
+	IsRooted   bool `json:"rooted"`
+}
+
+type serializedEdgeV1[K comparable] struct {
+	Source     K                 `json:"source"`
+	Target     K                 `json:"target"`
+	Weight     int               `json:"weight"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Marshal serializes the given graph as JSON, including its traits, vertices, and edges. The JSON
+// document has a version field denoting the format version, which allows Unmarshal to read graphs
+// that have been serialized by older versions of this library:
+//
+//	{
+//		"version": 1,
+//		"traits": {"directed": true, "acyclic": false, "weighted": true, "rooted": false},
+//		"vertices": ["A", "B"],
+//		"edges": [{"source": "A", "target": "B", "weight": 3, "attributes": {"color": "red"}}]
+//	}
+//
+// The vertex values and hashes have to be encodable by encoding/json. Vertices are written in
+// ascending order of their hashes and edges in ascending order of their source and target hashes,
+// so that equal graphs yield equal output. For an undirected graph, each edge is written once.
+func Marshal[K comparable, T any](g Graph[K, T]) ([]byte, error) {
+	serialized, err := serializeV1(g)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(serialized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode graph: %w", err)
+	}
+
+	return data, nil
+}
+
+// serializeV1 converts the given graph into version 1 of the serialization format, which is
+// shared by all encodings. Vertices and edges are sorted by their hashes.
+func serializeV1[K comparable, T any](g Graph[K, T]) (serializedGraphV1[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return serializedGraphV1[K, T]{}, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sortKeys(vertices)
+
+	traits := g.Traits()
+
+	serialized := serializedGraphV1[K, T]{
+		Version: serializationVersion,
+		Traits: serializedTraitsV1{
+			IsDirected: traits.IsDirected,
+			IsAcyclic:  traits.IsAcyclic,
+			IsWeighted: traits.IsWeighted,
+			IsRooted:   traits.IsRooted,
+		},
+		Vertices: make([]T, 0, len(vertices)),
+		Edges:    make([]serializedEdgeV1[K], 0),
+	}
+
+	for _, hash := range vertices {
+		vertex, err := g.Vertex(hash)
+		if err != nil {
+			return serializedGraphV1[K, T]{}, fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+		serialized.Vertices = append(serialized.Vertices, vertex)
+	}
+
+	for _, edge := range sortedEdges(adjacencyMap, traits.IsDirected) {
+		serialized.Edges = append(serialized.Edges, serializedEdgeV1[K]{
+			Source:     edge.Source,
+			Target:     edge.Target,
+			Weight:     edge.Properties.Weight,
+			Attributes: edge.Properties.Attributes,
+		})
+	}
+
+	return serialized, nil
+}
+
+// Unmarshal deserializes a graph that has been serialized using Marshal. The given hashing
+// function is used to compute the hashes of the deserialized vertices, so it should be the same
+// function that the serialized graph has been created with.
+//
+// Unmarshal supports all format versions written by current and previous versions of this library.
+// If the data has been written in a newer, unknown format version, an error wrapping
+// ErrUnsupportedVersion will be returned.
+func Unmarshal[K comparable, T any](data []byte, hash Hash[K, T]) (Graph[K, T], error) {
+	var envelope struct {
+		Version int `json:"version"`
+	}
+
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to decode graph: %w", err)
+	}
+
+	switch envelope.Version {
+	case 1:
+		return unmarshalV1(data, hash)
+	}
+
+	return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, envelope.Version)
+}
+
+func unmarshalV1[K comparable, T any](data []byte, hash Hash[K, T]) (Graph[K, T], error) {
+	var serialized serializedGraphV1[K, T]
+
+	if err := json.Unmarshal(data, &serialized); err != nil {
+		return nil, fmt.Errorf("failed to decode graph: %w", err)
+	}
+
+	return deserializeV1(serialized, hash)
+}
+
+// deserializeV1 creates a graph from version 1 of the serialization format, using the given hashing
+// function for the vertices.
+func deserializeV1[K comparable, T any](serialized serializedGraphV1[K, T], hash Hash[K, T]) (Graph[K, T], error) {
+	g := New(hash, func(t *Traits) {
+		t.IsDirected = serialized.Traits.IsDirected
+		t.IsAcyclic = serialized.Traits.IsAcyclic
+		t.IsWeighted = serialized.Traits.IsWeighted
+		t.IsRooted = serialized.Traits.IsRooted
+	})
+
+	for _, vertex := range serialized.Vertices {
+		if err := g.AddVertex(vertex); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", vertex, err)
+		}
+	}
+
+	for _, edge := range serialized.Edges {
+		properties := EdgeProperties{
+			Weight:     edge.Weight,
+			Attributes: edge.Attributes,
+		}
+
+		if err := g.AddEdge(edge.Source, edge.Target, copyEdgeProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,191 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMarshal(t *testing.T) {
+	tests := map[string]struct {
+		options  []func(*Traits)
+		vertices []string
+		edges    []Edge[string]
+		expected string
+	}{
+		"directed weighted graph": {
+			options:  []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"B", "A", "C"},
+			edges: []Edge[string]{
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3, Attributes: map[string]string{"color": "red"}}},
+			},
+			expected: `{"version":1,"traits":{"directed":true,"acyclic":false,"weighted":true,"rooted":false},"vertices":["A","B","C"],"edges":[{"source":"A","target":"B","weight":3,"attributes":{"color":"red"}},{"source":"B","target":"C","weight":2}]}`,
+		},
+		"empty graph": {
+			expected: `{"version":1,"traits":{"directed":false,"acyclic":false,"weighted":false,"rooted":false},"vertices":[],"edges":[]}`,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(StringHash, test.options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			options := []func(*EdgeProperties){EdgeWeight(edge.Properties.Weight)}
+			for key, value := range edge.Properties.Attributes {
+				options = append(options, EdgeAttribute(key, value))
+			}
+			if err := graph.AddEdge(edge.Source, edge.Target, options...); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		data, err := Marshal(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if string(data) != test.expected {
+			t.Errorf("%s: output expectancy doesn't match: expected %v, got %v", name, test.expected, string(data))
+		}
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	type city struct {
+		Name       string `json:"name"`
+		Population int    `json:"population"`
+	}
+
+	cityHash := func(c city) string {
+		return c.Name
+	}
+
+	tests := map[string]struct {
+		data             string
+		expectedTraits   Traits
+		expectedVertices []city
+		expectedEdges    []Edge[string]
+		expectedErr      error
+		shouldFail       bool
+	}{
+		"version 1": {
+			data: `{"version":1,"traits":{"directed":true,"acyclic":true,"weighted":true,"rooted":false},
+				"vertices":[{"name":"London","population":9000000},{"name":"Paris","population":2100000}],
+				"edges":[{"source":"London","target":"Paris","weight":344,"attributes":{"mode":"train"}}]}`,
+			expectedTraits: Traits{IsDirected: true, IsAcyclic: true, IsWeighted: true},
+			expectedVertices: []city{
+				{Name: "London", Population: 9000000},
+				{Name: "Paris", Population: 2100000},
+			},
+			expectedEdges: []Edge[string]{
+				{Source: "London", Target: "Paris", Properties: EdgeProperties{Weight: 344, Attributes: map[string]string{"mode": "train"}}},
+			},
+		},
+		"future version": {
+			data:        `{"version":2,"nodes":[]}`,
+			expectedErr: ErrUnsupportedVersion,
+			shouldFail:  true,
+		},
+		"missing version": {
+			data:        `{"vertices":[]}`,
+			expectedErr: ErrUnsupportedVersion,
+			shouldFail:  true,
+		},
+		"edge to unknown vertex": {
+			data:       `{"version":1,"traits":{},"vertices":[{"name":"London"}],"edges":[{"source":"London","target":"Rome"}]}`,
+			shouldFail: true,
+		},
+		"invalid JSON": {
+			data:       `{"version":`,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph, err := Unmarshal([]byte(test.data), cityHash)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+			t.Errorf("%s: error expectancy doesn't match: expected %v, got %v", name, test.expectedErr, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if *graph.Traits() != test.expectedTraits {
+			t.Errorf("%s: traits expectancy doesn't match: expected %v, got %v", name, test.expectedTraits, *graph.Traits())
+		}
+
+		for _, expectedVertex := range test.expectedVertices {
+			vertex, err := graph.Vertex(expectedVertex.Name)
+			if err != nil {
+				t.Fatalf("%s: vertex %v expected", name, expectedVertex.Name)
+			}
+
+			if vertex != expectedVertex {
+				t.Errorf("%s: vertex expectancy doesn't match: expected %v, got %v", name, expectedVertex, vertex)
+			}
+		}
+
+		for _, expectedEdge := range test.expectedEdges {
+			edge, err := graph.Edge(expectedEdge.Source, expectedEdge.Target)
+			if err != nil {
+				t.Fatalf("%s: edge (%v, %v) expected", name, expectedEdge.Source, expectedEdge.Target)
+			}
+
+			if !propertiesAreEqual(edge.Properties, expectedEdge.Properties) {
+				t.Errorf("%s: edge properties expectancy doesn't match: expected %v, got %v", name, expectedEdge.Properties, edge.Properties)
+			}
+		}
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	graph := New(IntHash, Weighted())
+
+	for i := 1; i <= 4; i++ {
+		_ = graph.AddVertex(i)
+	}
+
+	_ = graph.AddEdge(1, 2, EdgeWeight(5))
+	_ = graph.AddEdge(3, 2, EdgeWeight(1), EdgeAttribute("label", "x"))
+	_ = graph.AddEdge(4, 4)
+
+	data, err := Marshal(graph)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	restored, err := Unmarshal(data, IntHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if restored.Order() != graph.Order() || restored.Size() != graph.Size() {
+		t.Fatalf("order and size expectancy doesn't match: expected %v and %v, got %v and %v", graph.Order(), graph.Size(), restored.Order(), restored.Size())
+	}
+
+	expectedAdjacencyMap, _ := graph.AdjacencyMap()
+	adjacencyMap, _ := restored.AdjacencyMap()
+
+	for source, adjacencies := range expectedAdjacencyMap {
+		for target, expectedEdge := range adjacencies {
+			edge, ok := adjacencyMap[source][target]
+			if !ok {
+				t.Fatalf("edge (%v, %v) expected", source, target)
+			}
+
+			if !propertiesAreEqual(edge.Properties, expectedEdge.Properties) {
+				t.Errorf("edge properties expectancy doesn't match: expected %v, got %v", expectedEdge.Properties, edge.Properties)
+			}
+		}
+	}
+}
@@ -0,0 +1,437 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// MaxWeightBipartiteMatching computes a maximum weight matching in an undirected bipartite graph
+// using the Hungarian algorithm, also known as the Kuhn-Munkres algorithm. A matching is a set of
+// edges without common vertices, and its weight is the sum of its edge weights. If the graph isn't
+// weighted, each edge has a weight of 1 and the result is a maximum cardinality matching.
+//
+// The returned map contains both directions of each matched pair: If vertex a is matched with
+// vertex b, the map contains a->b as well as b->a. Unmatched vertices are not contained in the map.
+// The second return value is the total weight of the matching.
+//
+// The Hungarian algorithm operates on a square weight matrix. If the two partitions of the graph
+// have different sizes, the smaller partition is internally padded with dummy vertices connected
+// to all other vertices by zero-weight edges. Vertex pairs that are not joined by an edge are
+// treated the same way. Such pairs never appear in the resulting matching, and neither do edges
+// with a negative weight, because leaving their vertices unmatched always yields a higher weight.
+//
+// If the graph is not bipartite, an error is returned.
+func MaxWeightBipartiteMatching[K comparable, T any](g Graph[K, T]) (map[K]K, int, error) {
+	if g.Traits().IsDirected {
+		return nil, 0, errors.New("bipartite matchings can only be computed for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	left, right, ok := bipartition(adjacencyMap)
+	if !ok {
+		return nil, 0, errors.New("graph is not bipartite")
+	}
+
+	size := len(left)
+	if len(right) > size {
+		size = len(right)
+	}
+
+	weights := make([][]int, size)
+	for i := range weights {
+		weights[i] = make([]int, size)
+	}
+
+	isWeighted := g.Traits().IsWeighted
+
+	for i, l := range left {
+		for j, r := range right {
+			edge, ok := adjacencyMap[l][r]
+			if !ok {
+				continue
+			}
+
+			weight := 1
+			if isWeighted {
+				weight = edge.Properties.Weight
+			}
+
+			if weight > 0 {
+				weights[i][j] = weight
+			}
+		}
+	}
+
+	assignment := hungarian(weights)
+
+	matching := make(map[K]K)
+	totalWeight := 0
+
+	for i, j := range assignment {
+		if i >= len(left) || j >= len(right) {
+			continue
+		}
+
+		l, r := left[i], right[j]
+
+		if _, ok := adjacencyMap[l][r]; !ok || weights[i][j] <= 0 {
+			continue
+		}
+
+		matching[l] = r
+		matching[r] = l
+		totalWeight += weights[i][j]
+	}
+
+	return matching, totalWeight, nil
+}
+
+// hungarian solves the assignment problem for the given square weight matrix and returns the
+// column assigned to each row so that the sum of the assigned weights is maximal. It runs in
+// O(n^3) time by maintaining vertex potentials and growing the assignment one row at a time.
+func hungarian(weights [][]int) []int {
+	n := len(weights)
+
+	// The implementation minimizes costs, so the weights are negated. Rows and columns are indexed
+	// starting at 1, with row 0 and column 0 acting as sentinels.
+	rowPotentials := make([]int, n+1)
+	columnPotentials := make([]int, n+1)
+	columnRows := make([]int, n+1)
+	way := make([]int, n+1)
+
+	for row := 1; row <= n; row++ {
+		columnRows[0] = row
+		column := 0
+
+		minSlack := make([]int, n+1)
+		used := make([]bool, n+1)
+
+		for j := range minSlack {
+			minSlack[j] = math.MaxInt
+		}
+
+		for {
+			used[column] = true
+			currentRow := columnRows[column]
+			delta := math.MaxInt
+			nextColumn := 0
+
+			for j := 1; j <= n; j++ {
+				if used[j] {
+					continue
+				}
+
+				slack := -weights[currentRow-1][j-1] - rowPotentials[currentRow] - columnPotentials[j]
+
+				if slack < minSlack[j] {
+					minSlack[j] = slack
+					way[j] = column
+				}
+
+				if minSlack[j] < delta {
+					delta = minSlack[j]
+					nextColumn = j
+				}
+			}
+
+			for j := 0; j <= n; j++ {
+				if used[j] {
+					rowPotentials[columnRows[j]] += delta
+					columnPotentials[j] -= delta
+				} else {
+					minSlack[j] -= delta
+				}
+			}
+
+			column = nextColumn
+
+			if columnRows[column] == 0 {
+				break
+			}
+		}
+
+		for column != 0 {
+			previous := way[column]
+			columnRows[column] = columnRows[previous]
+			column = previous
+		}
+	}
+
+	assignment := make([]int, n)
+
+	for column := 1; column <= n; column++ {
+		if columnRows[column] != 0 {
+			assignment[columnRows[column]-1] = column - 1
+		}
+	}
+
+	return assignment
+}
+
+// WouldRemainBipartite determines whether an undirected graph would still be bipartite after
+// adding an edge between the given source and target vertices. It won't create that edge in any
+// case. If the graph already isn't bipartite, false will be returned regardless of the edge.
+//
+// WouldRemainBipartite builds a BipartiteChecker from the whole graph, which takes O(V+E) time. To
+// check each edge of an incrementally built graph, create a BipartiteChecker once and use its Check
+// and AddEdge methods instead, which also report the conflicting partition assignment.
+func WouldRemainBipartite[K comparable, T any](g Graph[K, T], source, target K) (bool, error) {
+	if g.Traits().IsDirected {
+		return false, errors.New("bipartiteness can only be determined for undirected graphs")
+	}
+
+	if !g.HasVertex(source) {
+		return false, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	if !g.HasVertex(target) {
+		return false, fmt.Errorf("could not find target vertex with hash %v", target)
+	}
+
+	checker, err := NewBipartiteChecker(g)
+	if err != nil {
+		return false, err
+	}
+
+	return checker.Check(source, target) == nil, nil
+}
+
+// BipartiteChecker incrementally keeps track of the two partitions of an undirected graph that is
+// built edge by edge, so that checking whether an edge keeps the graph bipartite takes nearly
+// constant time instead of a full bipartiteness check. It is created using NewBipartiteChecker.
+//
+// The vertices are assigned to the partitions using a union-find structure that tracks the parity
+// of each vertex relative to the representative of its subset. An edge keeps the graph bipartite
+// unless it joins two vertices of the same subset with the same parity, i.e. two vertices that are
+// bound to the same partition. Adding a self-loop never keeps a graph bipartite.
+//
+// The checker doesn't observe the graph it has been created from. Each edge added to the graph has
+// to be added to the checker using AddEdge as well:
+//
+//	checker, _ := graph.NewBipartiteChecker(g)
+//
+//	if err := checker.AddEdge("A", "B"); err != nil {
+//		var conflict *graph.BipartiteConflictError[string]
+//		if errors.As(err, &conflict) {
+//			fmt.Println(conflict.Source, conflict.Target, conflict.SourceSide)
+//		}
+//		return err
+//	}
+//	_ = g.AddEdge("A", "B")
+type BipartiteChecker[K comparable] struct {
+	sides *parityUnionFind[K]
+	// conflict is the first edge that made the graph non-bipartite, if any.
+	conflict *BipartiteConflictError[K]
+}
+
+// BipartiteConflictError will be returned by BipartiteChecker if an edge joins two vertices that
+// are bound to the same partition. The sides are 0 or 1 and are relative to the current assignment
+// of the connected component containing both vertices, so they are always equal. A conflicting
+// self-loop has the same source and target vertex.
+//
+// A BipartiteConflictError wraps ErrNotBipartite, so that it can be detected using errors.Is.
+type BipartiteConflictError[K comparable] struct {
+	Source     K
+	Target     K
+	SourceSide int
+	TargetSide int
+}
+
+func (b *BipartiteConflictError[K]) Error() string {
+	return fmt.Sprintf("an edge between %v (side %d) and %v (side %d) would join vertices of the same partition", b.Source, b.SourceSide, b.Target, b.TargetSide)
+}
+
+// Unwrap returns ErrNotBipartite.
+func (b *BipartiteConflictError[K]) Unwrap() error {
+	return ErrNotBipartite
+}
+
+// NewBipartiteChecker creates a BipartiteChecker containing all edges of the given undirected
+// graph, which takes O(V+E) time. If the graph already isn't bipartite, the checker is created
+// anyway and reports the edge that made it non-bipartite for every subsequent check.
+func NewBipartiteChecker[K comparable, T any](g Graph[K, T]) (*BipartiteChecker[K], error) {
+	if g.Traits().IsDirected {
+		return nil, errors.New("bipartiteness can only be determined for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	checker := &BipartiteChecker[K]{
+		sides: newParityUnionFind[K](),
+	}
+
+	for _, edge := range sortedEdges(adjacencyMap, false) {
+		if err := checker.AddEdge(edge.Source, edge.Target); err != nil {
+			break
+		}
+	}
+
+	return checker, nil
+}
+
+// Check determines whether adding an edge between the given source and target vertices would keep
+// the graph bipartite without adding the edge to the checker. If it wouldn't, a
+// *BipartiteConflictError describing the conflicting vertices will be returned. If the graph
+// already isn't bipartite, the error for the edge that made it non-bipartite will be returned.
+func (c *BipartiteChecker[K]) Check(source, target K) error {
+	if conflict := c.findConflict(source, target); conflict != nil {
+		return conflict
+	}
+
+	return nil
+}
+
+// AddEdge adds an edge between the given source and target vertices to the checker, binding them
+// to different partitions. If the edge makes the graph non-bipartite, it is recorded and the
+// *BipartiteConflictError returned by Check is returned.
+func (c *BipartiteChecker[K]) AddEdge(source, target K) error {
+	if conflict := c.findConflict(source, target); conflict != nil {
+		c.conflict = conflict
+		return conflict
+	}
+
+	c.sides.union(source, target)
+
+	return nil
+}
+
+// findConflict returns the conflict that adding the given edge would cause, or nil if there is none.
+func (c *BipartiteChecker[K]) findConflict(source, target K) *BipartiteConflictError[K] {
+	if c.conflict != nil {
+		return c.conflict
+	}
+
+	sourceRoot, sourceParity := c.sides.find(source)
+	targetRoot, targetParity := c.sides.find(target)
+
+	if sourceRoot != targetRoot || sourceParity != targetParity {
+		return nil
+	}
+
+	return &BipartiteConflictError[K]{
+		Source:     source,
+		Target:     target,
+		SourceSide: paritySide(sourceParity),
+		TargetSide: paritySide(targetParity),
+	}
+}
+
+func paritySide(parity bool) int {
+	if parity {
+		return 1
+	}
+	return 0
+}
+
+// parityUnionFind is a union-find structure that additionally keeps track of the parity of each
+// vertex relative to its parent. Two vertices of the same subset have to be placed in the same
+// partition if their parities relative to the representative are equal.
+type parityUnionFind[K comparable] struct {
+	parents  map[K]K
+	parities map[K]bool
+}
+
+func newParityUnionFind[K comparable]() *parityUnionFind[K] {
+	return &parityUnionFind[K]{
+		parents:  make(map[K]K),
+		parities: make(map[K]bool),
+	}
+}
+
+// find returns the representative of the subset containing the given vertex along with the parity
+// of the vertex relative to that representative.
+func (p *parityUnionFind[K]) find(vertex K) (K, bool) {
+	parent, ok := p.parents[vertex]
+	if !ok || parent == vertex {
+		return vertex, false
+	}
+
+	root, parentParity := p.find(parent)
+	p.parents[vertex] = root
+	p.parities[vertex] = p.parities[vertex] != parentParity
+
+	return root, p.parities[vertex]
+}
+
+// union requires the two given vertices to be placed in different partitions. It returns false if
+// they are already bound to the same partition.
+func (p *parityUnionFind[K]) union(a, b K) bool {
+	aRoot, aParity := p.find(a)
+	bRoot, bParity := p.find(b)
+
+	if aRoot == bRoot {
+		return aParity != bParity
+	}
+
+	p.parents[aRoot] = bRoot
+	p.parities[aRoot] = aParity == bParity
+
+	return true
+}
+
+// bipartition splits the vertices of an undirected graph into two partitions so that each edge
+// joins a vertex of the first partition with a vertex of the second partition. If this isn't
+// possible, the graph is not bipartite and false is returned. The vertices of each connected
+// component are assigned using a breadth-first search starting at its smallest vertex.
+func bipartition[K comparable](adjacencyMap map[K]map[K]Edge[K]) ([]K, []K, bool) {
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sortKeys(vertices)
+
+	sides := make(map[K]bool, len(adjacencyMap))
+	left := make([]K, 0)
+	right := make([]K, 0)
+
+	for _, start := range vertices {
+		if _, ok := sides[start]; ok {
+			continue
+		}
+
+		sides[start] = false
+		queue := []K{start}
+
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			if sides[current] {
+				right = append(right, current)
+			} else {
+				left = append(left, current)
+			}
+
+			adjacencies := make([]K, 0, len(adjacencyMap[current]))
+			for adjacency := range adjacencyMap[current] {
+				adjacencies = append(adjacencies, adjacency)
+			}
+
+			sortKeys(adjacencies)
+
+			for _, adjacency := range adjacencies {
+				side, ok := sides[adjacency]
+				if !ok {
+					sides[adjacency] = !sides[current]
+					queue = append(queue, adjacency)
+					continue
+				}
+
+				if side == sides[current] {
+					return nil, nil, false
+				}
+			}
+		}
+	}
+
+	return left, right, true
+}
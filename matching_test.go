@@ -0,0 +1,418 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestMaxWeightBipartiteMatching(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		isWeighted       bool
+		vertices         []string
+		edges            []Edge[string]
+		expectedMatching map[string]string
+		expectedWeight   int
+		shouldFail       bool
+	}{
+		"balanced weighted graph": {
+			isWeighted: true,
+			vertices:   []string{"a", "b", "c", "x", "y", "z"},
+			edges: []Edge[string]{
+				{Source: "a", Target: "x", Properties: EdgeProperties{Weight: 7}},
+				{Source: "a", Target: "y", Properties: EdgeProperties{Weight: 5}},
+				{Source: "a", Target: "z", Properties: EdgeProperties{Weight: 11}},
+				{Source: "b", Target: "x", Properties: EdgeProperties{Weight: 5}},
+				{Source: "b", Target: "y", Properties: EdgeProperties{Weight: 4}},
+				{Source: "b", Target: "z", Properties: EdgeProperties{Weight: 1}},
+				{Source: "c", Target: "x", Properties: EdgeProperties{Weight: 9}},
+				{Source: "c", Target: "y", Properties: EdgeProperties{Weight: 3}},
+				{Source: "c", Target: "z", Properties: EdgeProperties{Weight: 2}},
+			},
+			expectedMatching: map[string]string{
+				"a": "z", "z": "a",
+				"b": "y", "y": "b",
+				"c": "x", "x": "c",
+			},
+			expectedWeight: 24,
+		},
+		"unbalanced partitions": {
+			isWeighted: true,
+			vertices:   []string{"a", "x", "y", "z"},
+			edges: []Edge[string]{
+				{Source: "a", Target: "x", Properties: EdgeProperties{Weight: 2}},
+				{Source: "a", Target: "y", Properties: EdgeProperties{Weight: 8}},
+				{Source: "a", Target: "z", Properties: EdgeProperties{Weight: 3}},
+			},
+			expectedMatching: map[string]string{
+				"a": "y", "y": "a",
+			},
+			expectedWeight: 8,
+		},
+		"heavy edge beats two light edges": {
+			isWeighted: true,
+			vertices:   []string{"a", "b", "x", "y"},
+			edges: []Edge[string]{
+				{Source: "a", Target: "x", Properties: EdgeProperties{Weight: 10}},
+				{Source: "a", Target: "y", Properties: EdgeProperties{Weight: 4}},
+				{Source: "b", Target: "x", Properties: EdgeProperties{Weight: 4}},
+			},
+			expectedMatching: map[string]string{
+				"a": "x", "x": "a",
+			},
+			expectedWeight: 10,
+		},
+		"negative edge weight": {
+			isWeighted: true,
+			vertices:   []string{"a", "x"},
+			edges: []Edge[string]{
+				{Source: "a", Target: "x", Properties: EdgeProperties{Weight: -3}},
+			},
+			expectedMatching: map[string]string{},
+			expectedWeight:   0,
+		},
+		"unweighted graph": {
+			vertices: []string{"a", "b", "c", "x", "y", "z"},
+			edges: []Edge[string]{
+				{Source: "a", Target: "x"},
+				{Source: "a", Target: "y"},
+				{Source: "b", Target: "x"},
+				{Source: "c", Target: "y"},
+				{Source: "c", Target: "z"},
+			},
+			expectedWeight: 3,
+		},
+		"odd cycle": {
+			vertices: []string{"a", "b", "c"},
+			edges: []Edge[string]{
+				{Source: "a", Target: "b"},
+				{Source: "b", Target: "c"},
+				{Source: "c", Target: "a"},
+			},
+			shouldFail: true,
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []string{"a", "b"},
+			edges: []Edge[string]{
+				{Source: "a", Target: "b"},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[string, string]
+
+		switch {
+		case test.isDirected:
+			graph = New(StringHash, Directed())
+		case test.isWeighted:
+			graph = New(StringHash, Weighted())
+		default:
+			graph = New(StringHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		matching, weight, err := MaxWeightBipartiteMatching(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if weight != test.expectedWeight {
+			t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, test.expectedWeight, weight)
+		}
+
+		if test.expectedMatching != nil && len(matching) != len(test.expectedMatching) {
+			t.Errorf("%s: matching size expectancy doesn't match: expected %v, got %v", name, len(test.expectedMatching), len(matching))
+		}
+
+		for vertex, expectedPartner := range test.expectedMatching {
+			if partner := matching[vertex]; partner != expectedPartner {
+				t.Errorf("%s: partner expectancy for %v doesn't match: expected %v, got %v", name, vertex, expectedPartner, partner)
+			}
+		}
+
+		for vertex, partner := range matching {
+			if matching[partner] != vertex {
+				t.Errorf("%s: matching is not symmetric for %v and %v", name, vertex, partner)
+			}
+
+			if _, err := graph.Edge(vertex, partner); err != nil {
+				t.Errorf("%s: matched vertices %v and %v are not adjacent", name, vertex, partner)
+			}
+		}
+	}
+}
+
+func TestWouldRemainBipartite(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		vertices   []int
+		edges      []Edge[int]
+		source     int
+		target     int
+		expected   bool
+		shouldFail bool
+	}{
+		"edge between partitions": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			source:   1,
+			target:   4,
+			expected: true,
+		},
+		"edge within a partition": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			source:   1,
+			target:   3,
+			expected: false,
+		},
+		"edge between components": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 4},
+			},
+			source:   1,
+			target:   3,
+			expected: true,
+		},
+		"existing edge": {
+			vertices: []int{1, 2},
+			edges:    []Edge[int]{{Source: 1, Target: 2}},
+			source:   2,
+			target:   1,
+			expected: true,
+		},
+		"self-loop": {
+			vertices: []int{1, 2},
+			source:   1,
+			target:   1,
+			expected: false,
+		},
+		"graph that isn't bipartite": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			source:   4,
+			target:   5,
+			expected: false,
+		},
+		"unknown target": {
+			vertices:   []int{1},
+			source:     1,
+			target:     2,
+			shouldFail: true,
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			source:     1,
+			target:     2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		remainsBipartite, err := WouldRemainBipartite(graph, test.source, test.target)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if remainsBipartite != test.expected {
+			t.Errorf("%s: bipartiteness expectancy doesn't match: expected %v, got %v", name, test.expected, remainsBipartite)
+		}
+	}
+}
+
+// TestBipartiteChecker_Random builds random graphs edge by edge, checking each edge with a single
+// BipartiteChecker, and compares the outcome with a full bipartiteness check after each edge.
+func TestBipartiteChecker_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for round := 0; round < 20; round++ {
+		n := 12
+		graph := New(IntHash)
+
+		for i := 0; i < n; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		checker, err := NewBipartiteChecker(graph)
+		if err != nil {
+			t.Fatalf("failed to create checker: %s", err.Error())
+		}
+
+		for i := 0; i < 2*n; i++ {
+			source, target := rng.Intn(n), rng.Intn(n)
+			if graph.HasEdge(source, target) {
+				continue
+			}
+
+			checkErr := checker.Check(source, target)
+
+			remainsBipartite, err := WouldRemainBipartite(graph, source, target)
+			if err != nil {
+				t.Fatalf("failed to check bipartiteness: %s", err.Error())
+			}
+
+			_ = graph.AddEdge(source, target)
+			addErr := checker.AddEdge(source, target)
+
+			adjacencyMap, _ := graph.AdjacencyMap()
+			_, _, isBipartite := bipartition(adjacencyMap)
+
+			if remainsBipartite != isBipartite || (checkErr == nil) != isBipartite || (addErr == nil) != isBipartite {
+				t.Fatalf("(%d, %d): bipartiteness expectancy doesn't match: expected %v, got %v, %v, and %v", source, target, isBipartite, remainsBipartite, checkErr, addErr)
+			}
+
+			if !isBipartite {
+				var conflict *BipartiteConflictError[int]
+				if !errors.As(addErr, &conflict) || conflict.Source != source || conflict.Target != target {
+					t.Fatalf("(%d, %d): conflict expectancy doesn't match: got %v", source, target, addErr)
+				}
+				break
+			}
+		}
+	}
+}
+
+func TestBipartiteChecker(t *testing.T) {
+	tests := map[string]struct {
+		edges            []Edge[int]
+		source           int
+		target           int
+		expectedConflict *BipartiteConflictError[int]
+	}{
+		"edge between partitions": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			source: 3,
+			target: 4,
+		},
+		"edge within a partition": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			source:           1,
+			target:           3,
+			expectedConflict: &BipartiteConflictError[int]{Source: 1, Target: 3},
+		},
+		"self-loop": {
+			source:           1,
+			target:           1,
+			expectedConflict: &BipartiteConflictError[int]{Source: 1, Target: 1},
+		},
+		"graph that isn't bipartite": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+			source:           4,
+			target:           5,
+			expectedConflict: &BipartiteConflictError[int]{Source: 2, Target: 3},
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		for i := 1; i <= 5; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		checker, err := NewBipartiteChecker(graph)
+		if err != nil {
+			t.Fatalf("%s: failed to create checker: %s", name, err.Error())
+		}
+
+		err = checker.Check(test.source, test.target)
+
+		if (test.expectedConflict != nil) != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.expectedConflict != nil, err != nil, err)
+		}
+
+		if test.expectedConflict == nil {
+			continue
+		}
+
+		if !errors.Is(err, ErrNotBipartite) {
+			t.Errorf("%s: error expectancy doesn't match: expected %v, got %v", name, ErrNotBipartite, err)
+		}
+
+		var conflict *BipartiteConflictError[int]
+		if !errors.As(err, &conflict) {
+			t.Fatalf("%s: error is not a *BipartiteConflictError: %v", name, err)
+		}
+
+		if conflict.Source != test.expectedConflict.Source || conflict.Target != test.expectedConflict.Target {
+			t.Errorf("%s: conflict expectancy doesn't match: expected (%v, %v), got (%v, %v)", name, test.expectedConflict.Source, test.expectedConflict.Target, conflict.Source, conflict.Target)
+		}
+
+		if conflict.SourceSide != conflict.TargetSide {
+			t.Errorf("%s: conflicting vertices are on different sides: %v and %v", name, conflict.SourceSide, conflict.TargetSide)
+		}
+	}
+}
+
+func TestNewBipartiteChecker_Directed(t *testing.T) {
+	if _, err := NewBipartiteChecker(New(IntHash, Directed())); err == nil {
+		t.Error("error expectancy doesn't match: expected an error, got nil")
+	}
+}
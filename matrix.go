@@ -0,0 +1,261 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// IncidenceMatrix computes the incidence matrix of the graph. The matrix has one row for each
+// vertex and one column for each edge. The returned vertices and edges slices define the order of
+// the rows and columns: matrix[i][j] is the entry for vertices[i] and edges[j].
+//
+// In a directed graph, the entry for the source vertex of an edge is -1 and the entry for the
+// target vertex is 1. In an undirected graph, both entries are 1. All other entries are 0. A
+// self-loop has an entry of 2 in an undirected graph, since both ends of the edge are incident to
+// the same vertex, and an entry of 0 in a directed graph, since its source and target cancel out.
+//
+// The vertices are sorted in ascending order of their hashes, and the edges are sorted by their
+// source and target hashes. In an undirected graph, each edge is contained only once.
+func IncidenceMatrix[K comparable, T any](g Graph[K, T]) ([][]int, []K, []Edge[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sortKeys(vertices)
+
+	indices := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		indices[vertex] = i
+	}
+
+	isDirected := g.Traits().IsDirected
+	edges := sortedEdges(adjacencyMap, isDirected)
+
+	matrix := make([][]int, len(vertices))
+	for i := range matrix {
+		matrix[i] = make([]int, len(edges))
+	}
+
+	for j, edge := range edges {
+		source, target := indices[edge.Source], indices[edge.Target]
+
+		if isDirected {
+			matrix[source][j]--
+			matrix[target][j]++
+		} else {
+			matrix[source][j]++
+			matrix[target][j]++
+		}
+	}
+
+	return matrix, vertices, edges, nil
+}
+
+// FromAdjacencyMatrix creates a graph from the given adjacency matrix. The matrix must be square,
+// and it has one row and one column for each vertex: matrix[i][j] is the entry for the edge from
+// vertex i to vertex j. Each non-zero entry creates an edge, and for weighted graphs, the entry is
+// used as the edge weight. Entries on the diagonal create self-loops.
+//
+// The vertex values are taken from keys, so that keys[i] is the vertex for row i. keys must either
+// contain one unique value per row or be empty, in which case the row indices are used as vertex
+// values. The graph is created with the given traits. If the Directed trait isn't set, the graph
+// still is directed if the matrix isn't symmetric. Undirected edges are only added once.
+func FromAdjacencyMatrix(matrix [][]int, keys []int, hash Hash[int, int], options ...func(*Traits)) (Graph[int, int], error) {
+	n := len(matrix)
+
+	for i, row := range matrix {
+		if len(row) != n {
+			return nil, fmt.Errorf("matrix must be square, but row %d has %d entries instead of %d", i, len(row), n)
+		}
+	}
+
+	if len(keys) == 0 {
+		keys = make([]int, n)
+		for i := range keys {
+			keys[i] = i
+		}
+	}
+
+	if len(keys) != n {
+		return nil, fmt.Errorf("expected %d keys, got %d", n, len(keys))
+	}
+
+	hashes := make([]int, n)
+	seen := make(map[int]bool, n)
+
+	for i, key := range keys {
+		hashes[i] = hash(key)
+		if seen[hashes[i]] {
+			return nil, fmt.Errorf("duplicate key %d", key)
+		}
+		seen[hashes[i]] = true
+	}
+
+	symmetric := true
+
+	for i := 0; i < n && symmetric; i++ {
+		for j := i + 1; j < n; j++ {
+			if matrix[i][j] != matrix[j][i] {
+				symmetric = false
+				break
+			}
+		}
+	}
+
+	if !symmetric {
+		options = append(options, Directed())
+	}
+
+	g := New(hash, options...)
+	isDirected, isWeighted := g.Traits().IsDirected, g.Traits().IsWeighted
+
+	for _, key := range keys {
+		if err := g.AddVertex(key); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %d: %w", key, err)
+		}
+	}
+
+	for i, row := range matrix {
+		for j, entry := range row {
+			if entry == 0 || !isDirected && j < i {
+				continue
+			}
+
+			var edgeOptions []func(*EdgeProperties)
+			if isWeighted {
+				edgeOptions = append(edgeOptions, EdgeWeight(entry))
+			}
+
+			if err := g.AddEdge(hashes[i], hashes[j], edgeOptions...); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%d, %d): %w", hashes[i], hashes[j], err)
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// SpanningTreeCount computes the number of spanning trees of an undirected graph using Kirchhoff's
+// matrix tree theorem: The number of spanning trees equals the determinant of the Laplacian matrix
+// of the graph with one row and the corresponding column removed. A disconnected graph has no
+// spanning trees, so 0 will be returned for it. Self-loops don't affect the result.
+//
+// The determinant is computed exactly using the fraction-free Bareiss algorithm with arbitrary-
+// precision integers, so there are no intermediate overflows. However, the number of spanning
+// trees grows very fast: A complete graph with n vertices has n^(n-2) spanning trees. If the
+// result exceeds the range of an int64, which already is the case for a complete graph with 18
+// vertices, an error will be returned.
+func SpanningTreeCount[K comparable, T any](g Graph[K, T]) (int64, error) {
+	if g.Traits().IsDirected {
+		return 0, errors.New("spanning trees can only be counted in undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if len(adjacencyMap) == 0 {
+		return 0, errors.New("spanning trees can only be counted in graphs with at least one vertex")
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	indices := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		indices[vertex] = i
+	}
+
+	// Build the Laplacian matrix without the row and column of the last vertex.
+	n := len(vertices) - 1
+	laplacian := make([][]*big.Int, n)
+
+	for i := range laplacian {
+		laplacian[i] = make([]*big.Int, n)
+		for j := range laplacian[i] {
+			laplacian[i][j] = new(big.Int)
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for adjacency := range adjacencyMap[vertices[i]] {
+			if adjacency == vertices[i] {
+				continue
+			}
+
+			laplacian[i][i].Add(laplacian[i][i], big.NewInt(1))
+
+			if j := indices[adjacency]; j < n {
+				laplacian[i][j].Sub(laplacian[i][j], big.NewInt(1))
+			}
+		}
+	}
+
+	count := determinant(laplacian)
+
+	if !count.IsInt64() {
+		return 0, fmt.Errorf("number of spanning trees %v exceeds the range of int64", count)
+	}
+
+	return count.Int64(), nil
+}
+
+// determinant computes the determinant of the given square matrix using the Bareiss algorithm. The
+// matrix is modified in the process. The determinant of an empty matrix is 1.
+func determinant(matrix [][]*big.Int) *big.Int {
+	n := len(matrix)
+
+	if n == 0 {
+		return big.NewInt(1)
+	}
+
+	sign := 1
+	previous := big.NewInt(1)
+
+	for k := 0; k < n-1; k++ {
+		if matrix[k][k].Sign() == 0 {
+			swapped := false
+
+			for i := k + 1; i < n; i++ {
+				if matrix[i][k].Sign() != 0 {
+					matrix[k], matrix[i] = matrix[i], matrix[k]
+					sign = -sign
+					swapped = true
+					break
+				}
+			}
+
+			if !swapped {
+				return big.NewInt(0)
+			}
+		}
+
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				value := new(big.Int).Mul(matrix[i][j], matrix[k][k])
+				value.Sub(value, new(big.Int).Mul(matrix[i][k], matrix[k][j]))
+				matrix[i][j] = value.Quo(value, previous)
+			}
+		}
+
+		previous = matrix[k][k]
+	}
+
+	result := new(big.Int).Set(matrix[n-1][n-1])
+
+	if sign < 0 {
+		result.Neg(result)
+	}
+
+	return result
+}
@@ -0,0 +1,331 @@
+package graph
+
+import "testing"
+
+func TestIncidenceMatrix(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		vertices         []int
+		edges            []Edge[int]
+		expectedMatrix   [][]int
+		expectedVertices []int
+		expectedEdges    []Edge[int]
+	}{
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{3, 1, 2},
+			edges: []Edge[int]{
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 1},
+			},
+			expectedMatrix: [][]int{
+				{-1, 0, 1},
+				{1, -1, 0},
+				{0, 1, -1},
+			},
+			expectedVertices: []int{1, 2, 3},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+		},
+		"undirected graph with self-loop": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 3},
+			},
+			expectedMatrix: [][]int{
+				{1, 0, 0},
+				{1, 1, 0},
+				{0, 1, 2},
+			},
+			expectedVertices: []int{1, 2, 3},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 3},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		matrix, vertices, edges, err := IncidenceMatrix(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		for i, expectedVertex := range test.expectedVertices {
+			if vertices[i] != expectedVertex {
+				t.Errorf("%s: vertex order expectancy doesn't match: expected %v, got %v", name, test.expectedVertices, vertices)
+				break
+			}
+		}
+
+		if len(edges) != len(test.expectedEdges) {
+			t.Fatalf("%s: edge count expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), len(edges))
+		}
+
+		for i, expectedEdge := range test.expectedEdges {
+			if edges[i].Source != expectedEdge.Source || edges[i].Target != expectedEdge.Target {
+				t.Errorf("%s: edge order expectancy doesn't match: expected %v at %d, got %v", name, expectedEdge, i, edges[i])
+			}
+		}
+
+		for i, row := range test.expectedMatrix {
+			for j, expectedEntry := range row {
+				if matrix[i][j] != expectedEntry {
+					t.Errorf("%s: matrix expectancy doesn't match: expected %v at (%d, %d), got %v", name, expectedEntry, i, j, matrix[i][j])
+				}
+			}
+		}
+	}
+}
+
+func TestFromAdjacencyMatrix(t *testing.T) {
+	tests := map[string]struct {
+		matrix             [][]int
+		keys               []int
+		traits             []func(*Traits)
+		expectedIsDirected bool
+		expectedVertices   []int
+		expectedEdges      []Edge[int]
+		shouldFail         bool
+	}{
+		"symmetric matrix": {
+			matrix: [][]int{
+				{0, 1, 1},
+				{1, 0, 0},
+				{1, 0, 0},
+			},
+			expectedVertices: []int{0, 1, 2},
+			expectedEdges: []Edge[int]{
+				{Source: 0, Target: 1},
+				{Source: 0, Target: 2},
+			},
+		},
+		"asymmetric matrix": {
+			matrix: [][]int{
+				{0, 1},
+				{0, 0},
+			},
+			expectedIsDirected: true,
+			expectedVertices:   []int{0, 1},
+			expectedEdges: []Edge[int]{
+				{Source: 0, Target: 1},
+			},
+		},
+		"symmetric matrix with directed trait": {
+			matrix: [][]int{
+				{0, 1},
+				{1, 0},
+			},
+			traits:             []func(*Traits){Directed()},
+			expectedIsDirected: true,
+			expectedVertices:   []int{0, 1},
+			expectedEdges: []Edge[int]{
+				{Source: 0, Target: 1},
+				{Source: 1, Target: 0},
+			},
+		},
+		"weighted matrix with keys": {
+			matrix: [][]int{
+				{0, 5, 0},
+				{5, 0, -3},
+				{0, -3, 0},
+			},
+			keys:             []int{10, 20, 30},
+			traits:           []func(*Traits){Weighted()},
+			expectedVertices: []int{10, 20, 30},
+			expectedEdges: []Edge[int]{
+				{Source: 10, Target: 20, Properties: EdgeProperties{Weight: 5}},
+				{Source: 20, Target: 30, Properties: EdgeProperties{Weight: -3}},
+			},
+		},
+		"directed matrix with self-loop": {
+			matrix: [][]int{
+				{1, 1},
+				{0, 0},
+			},
+			expectedIsDirected: true,
+			expectedVertices:   []int{0, 1},
+			expectedEdges: []Edge[int]{
+				{Source: 0, Target: 0},
+				{Source: 0, Target: 1},
+			},
+		},
+		"empty matrix": {
+			matrix:           [][]int{},
+			expectedVertices: []int{},
+			expectedEdges:    []Edge[int]{},
+		},
+		"non-square matrix": {
+			matrix: [][]int{
+				{0, 1},
+				{1},
+			},
+			shouldFail: true,
+		},
+		"wrong number of keys": {
+			matrix: [][]int{
+				{0, 1},
+				{1, 0},
+			},
+			keys:       []int{1},
+			shouldFail: true,
+		},
+		"duplicate keys": {
+			matrix: [][]int{
+				{0, 1},
+				{1, 0},
+			},
+			keys:       []int{1, 1},
+			shouldFail: true,
+		},
+		"cyclic matrix with acyclic trait": {
+			matrix: [][]int{
+				{0, 1},
+				{1, 0},
+			},
+			traits:     []func(*Traits){Directed(), Acyclic()},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph, err := FromAdjacencyMatrix(test.matrix, test.keys, IntHash, test.traits...)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if graph.Traits().IsDirected != test.expectedIsDirected {
+			t.Errorf("%s: directedness expectancy doesn't match: expected %v, got %v", name, test.expectedIsDirected, graph.Traits().IsDirected)
+		}
+
+		adjacencyMap, _ := graph.AdjacencyMap()
+		vertices := sortedMapKeys(adjacencyMap)
+
+		if !orderedSlicesAreEqual(vertices, test.expectedVertices) {
+			t.Errorf("%s: vertex expectancy doesn't match: expected %v, got %v", name, test.expectedVertices, vertices)
+		}
+
+		if graph.Size() != len(test.expectedEdges) {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), graph.Size())
+		}
+
+		for _, expectedEdge := range test.expectedEdges {
+			edge, err := graph.Edge(expectedEdge.Source, expectedEdge.Target)
+			if err != nil {
+				t.Errorf("%s: expected edge (%v, %v) doesn't exist", name, expectedEdge.Source, expectedEdge.Target)
+				continue
+			}
+
+			if edge.Properties.Weight != expectedEdge.Properties.Weight {
+				t.Errorf("%s: weight expectancy of (%v, %v) doesn't match: expected %v, got %v", name, expectedEdge.Source, expectedEdge.Target, expectedEdge.Properties.Weight, edge.Properties.Weight)
+			}
+		}
+	}
+}
+
+func TestSpanningTreeCount(t *testing.T) {
+	tests := map[string]struct {
+		vertices      []int
+		edges         []Edge[int]
+		expectedCount int64
+		shouldFail    bool
+	}{
+		"triangle": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedCount: 3,
+		},
+		"complete graph with 4 vertices": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expectedCount: 16,
+		},
+		"tree with self-loop": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 3},
+			},
+			expectedCount: 1,
+		},
+		"disconnected graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedCount: 0,
+		},
+		"single vertex": {
+			vertices:      []int{1},
+			expectedCount: 1,
+		},
+		"empty graph": {
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		count, err := SpanningTreeCount(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if count != test.expectedCount {
+			t.Errorf("%s: count expectancy doesn't match: expected %v, got %v", name, test.expectedCount, count)
+		}
+	}
+}
@@ -0,0 +1,625 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// DegreeAssortativity computes the degree assortativity coefficient of the graph, which is the
+// Pearson correlation coefficient of the degrees of the vertices at both ends of each edge. It
+// ranges from -1 to 1: A positive value means that vertices tend to be joined with vertices of a
+// similar degree, whereas a negative value means that high-degree vertices tend to be joined with
+// low-degree vertices.
+//
+// For an undirected graph, each edge is considered in both directions, and the degree of a vertex
+// is its number of adjacent vertices. For a directed graph, the out-degree of the source vertex is
+// correlated with the in-degree of the target vertex for each edge.
+//
+// If the graph has no edges or all degrees at either end of the edges are equal, the correlation
+// is undefined and an error will be returned.
+func DegreeAssortativity[K comparable, T any](g Graph[K, T]) (float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	var sourceDegrees, targetDegrees []float64
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			sourceDegrees = append(sourceDegrees, float64(len(adjacencyMap[source])))
+			targetDegrees = append(targetDegrees, float64(len(predecessorMap[target])))
+		}
+	}
+
+	if len(sourceDegrees) == 0 {
+		return 0, errors.New("assortativity is undefined for graphs without edges")
+	}
+
+	sourceMean, targetMean := mean(sourceDegrees), mean(targetDegrees)
+
+	var covariance, sourceVariance, targetVariance float64
+
+	for i := range sourceDegrees {
+		sourceDeviation := sourceDegrees[i] - sourceMean
+		targetDeviation := targetDegrees[i] - targetMean
+
+		covariance += sourceDeviation * targetDeviation
+		sourceVariance += sourceDeviation * sourceDeviation
+		targetVariance += targetDeviation * targetDeviation
+	}
+
+	if sourceVariance == 0 || targetVariance == 0 {
+		return 0, errors.New("assortativity is undefined for graphs where all degrees are equal")
+	}
+
+	return covariance / math.Sqrt(sourceVariance*targetVariance), nil
+}
+
+func mean(values []float64) float64 {
+	sum := 0.0
+
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
+
+// Center returns the hashes of all center vertices of the graph, i.e. the vertices whose
+// eccentricity equals the radius of the graph. The eccentricity of a vertex is the greatest
+// distance from that vertex to any other vertex, and the radius is the smallest eccentricity.
+//
+// If the graph is weighted, the distances are the sums of the edge weights along the shortest
+// paths, which requires non-negative weights. Otherwise, each edge counts as one hop. In a
+// directed graph, the distances are measured along the edge directions.
+//
+// Eccentricities are only finite in connected graphs. If a vertex cannot reach all other vertices,
+// which is the case for disconnected graphs and directed graphs that aren't strongly connected, an
+// error will be returned. The vertices are returned in ascending order of their hashes.
+func Center[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	return extremeEccentricityVertices(g, false)
+}
+
+// Periphery returns the hashes of all peripheral vertices of the graph, i.e. the vertices whose
+// eccentricity equals the diameter of the graph, which is the greatest eccentricity. Distances and
+// disconnected graphs are handled the same way as by Center.
+func Periphery[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	return extremeEccentricityVertices(g, true)
+}
+
+// extremeEccentricityVertices returns the vertices with the smallest or, if maximum is true, the
+// greatest eccentricity.
+func extremeEccentricityVertices[K comparable, T any](g Graph[K, T], maximum bool) ([]K, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	eccentricities := make(map[K]float64, len(adjacencyMap))
+
+	for vertex := range adjacencyMap {
+		distances := shortestDistances(adjacencyMap, vertex, g.Traits().IsWeighted)
+		if len(distances) != len(adjacencyMap) {
+			return nil, fmt.Errorf("vertex %v cannot reach all other vertices, so its eccentricity is infinite", vertex)
+		}
+
+		for _, distance := range distances {
+			if distance > eccentricities[vertex] {
+				eccentricities[vertex] = distance
+			}
+		}
+	}
+
+	extreme := math.Inf(1)
+	if maximum {
+		extreme = math.Inf(-1)
+	}
+
+	for _, eccentricity := range eccentricities {
+		if maximum && eccentricity > extreme || !maximum && eccentricity < extreme {
+			extreme = eccentricity
+		}
+	}
+
+	vertices := make([]K, 0)
+
+	for vertex, eccentricity := range eccentricities {
+		if eccentricity == extreme {
+			vertices = append(vertices, vertex)
+		}
+	}
+
+	sortKeys(vertices)
+
+	return vertices, nil
+}
+
+// DiameterPath returns a longest shortest path in the graph, i.e. a shortest path between two
+// vertices whose distance equals the diameter of the graph, along with the length of that path.
+// The path includes both end vertices. Distances are measured like Center does: using the edge
+// weights in a weighted graph and one hop per edge otherwise.
+//
+// For undirected graphs created with the Tree trait, the path is found with two searches in linear
+// time. All other graphs require a shortest path search from each vertex, and if there are multiple
+// longest shortest paths, the one with the smallest end vertices is returned.
+//
+// The diameter is only finite in connected graphs. If a vertex cannot reach all other vertices, an
+// error wrapping ErrTargetNotReachable will be returned. For a graph without any vertices,
+// DiameterPath returns an empty path.
+func DiameterPath[K comparable, T any](g Graph[K, T]) ([]K, int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if len(adjacencyMap) == 0 {
+		return []K{}, 0, nil
+	}
+
+	traits := g.Traits()
+	vertices := sortedMapKeys(adjacencyMap)
+
+	farthest := func(source K) (K, float64, map[K]K, error) {
+		distances, predecessors := singleSourceShortestPaths(adjacencyMap, source, traits.IsWeighted, keyLess[K])
+		if len(distances) != len(adjacencyMap) {
+			return source, 0, nil, fmt.Errorf("%w: vertex %v cannot reach all other vertices", ErrTargetNotReachable, source)
+		}
+
+		target, maxDistance := source, 0.0
+
+		for _, vertex := range vertices {
+			if distances[vertex] > maxDistance {
+				target, maxDistance = vertex, distances[vertex]
+			}
+		}
+
+		return target, maxDistance, predecessors, nil
+	}
+
+	var (
+		bestSource       K
+		bestTarget       K
+		bestDistance     = -1.0
+		bestPredecessors map[K]K
+	)
+
+	if !traits.IsDirected && traits.IsAcyclic && traits.IsRooted {
+		// In a tree, the vertex farthest from an arbitrary vertex is an end of a longest path.
+		end, _, _, err := farthest(vertices[0])
+		if err != nil {
+			return nil, 0, err
+		}
+
+		bestSource = end
+		bestTarget, bestDistance, bestPredecessors, err = farthest(end)
+		if err != nil {
+			return nil, 0, err
+		}
+	} else {
+		for _, vertex := range vertices {
+			target, distance, predecessors, err := farthest(vertex)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if distance > bestDistance {
+				bestSource, bestTarget, bestDistance, bestPredecessors = vertex, target, distance, predecessors
+			}
+		}
+	}
+
+	path := []K{bestTarget}
+
+	for current := bestTarget; current != bestSource; {
+		current = bestPredecessors[current]
+		path = append(path, current)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, int(bestDistance), nil
+}
+
+// WienerIndex computes the Wiener index of the graph, which is the sum of the distances between all
+// pairs of vertices. In an undirected graph, each unordered pair of vertices is counted once, while
+// in a directed graph, the distances in both directions are counted. Distances are measured like
+// Center does: using the edge weights in a weighted graph and one hop per edge otherwise.
+//
+// The Wiener index is only finite in connected graphs. If a vertex cannot reach all other vertices,
+// an error wrapping ErrTargetNotReachable will be returned. For a directed graph, this means that
+// the graph has to be strongly connected.
+func WienerIndex[K comparable, T any](g Graph[K, T]) (int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	traits := g.Traits()
+	total := 0.0
+
+	for vertex := range adjacencyMap {
+		distances := shortestDistances(adjacencyMap, vertex, traits.IsWeighted)
+		if len(distances) != len(adjacencyMap) {
+			return 0, fmt.Errorf("%w: vertex %v cannot reach all other vertices", ErrTargetNotReachable, vertex)
+		}
+
+		for _, distance := range distances {
+			total += distance
+		}
+	}
+
+	// In an undirected graph, the distance between each pair of vertices has been summed up twice.
+	if !traits.IsDirected {
+		total /= 2
+	}
+
+	return int(total), nil
+}
+
+// TriangleCount returns the number of triangles in an undirected graph, i.e. the number of sets of
+// three vertices that are pairwise adjacent. Self-loops don't form triangles and are ignored.
+//
+// TriangleCount uses the forward algorithm, a node-iterator variant that only considers each edge
+// in the direction from the vertex with the lower degree to the vertex with the higher degree. It
+// runs in O(E^1.5) time, which is much faster than checking all triples of vertices.
+//
+// Triangles are only defined for undirected graphs. For a directed graph, an error is returned.
+func TriangleCount[K comparable, T any](g Graph[K, T]) (int, error) {
+	if g.Traits().IsDirected {
+		return 0, errors.New("triangles can only be counted in undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	// Each triangle is counted exactly once, at its edge between the two lowest-ranked vertices.
+	ranksBelow := func(a, b K) bool {
+		if len(adjacencyMap[a]) != len(adjacencyMap[b]) {
+			return len(adjacencyMap[a]) < len(adjacencyMap[b])
+		}
+		return keyLess(a, b)
+	}
+
+	forward := make(map[K]map[K]bool, len(adjacencyMap))
+
+	for vertex, adjacencies := range adjacencyMap {
+		forward[vertex] = make(map[K]bool)
+
+		for adjacency := range adjacencies {
+			if adjacency != vertex && ranksBelow(vertex, adjacency) {
+				forward[vertex][adjacency] = true
+			}
+		}
+	}
+
+	triangles := 0
+
+	for _, successors := range forward {
+		for successor := range successors {
+			for common := range forward[successor] {
+				if successors[common] {
+					triangles++
+				}
+			}
+		}
+	}
+
+	return triangles, nil
+}
+
+// TrianglesThrough returns the number of triangles in an undirected graph that contain the given
+// vertex, i.e. the number of edges between the neighbours of the vertex. Self-loops are ignored.
+// The local clustering coefficient of the vertex is this number divided by d(d-1)/2, where d is the
+// number of its neighbours.
+//
+// Like TriangleCount, TrianglesThrough only works for undirected graphs.
+func TrianglesThrough[K comparable, T any](g Graph[K, T], vertex K) (int, error) {
+	if g.Traits().IsDirected {
+		return 0, errors.New("triangles can only be counted in undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[vertex]; !ok {
+		return 0, fmt.Errorf("could not find vertex with hash %v", vertex)
+	}
+
+	neighbours := make([]K, 0, len(adjacencyMap[vertex]))
+	for adjacency := range adjacencyMap[vertex] {
+		if adjacency != vertex {
+			neighbours = append(neighbours, adjacency)
+		}
+	}
+
+	triangles := 0
+
+	for i, a := range neighbours {
+		for _, b := range neighbours[i+1:] {
+			if _, ok := adjacencyMap[a][b]; ok {
+				triangles++
+			}
+		}
+	}
+
+	return triangles, nil
+}
+
+// GraphStats is a summary of common metrics of a graph, as computed by Stats.
+type GraphStats struct {
+	// Order is the number of vertices.
+	Order int
+	// Size is the number of edges, including self-loops.
+	Size int
+	// Density is the ratio of the number of edges to the number of possible edges, ignoring
+	// self-loops. It is 0 for graphs with less than two vertices.
+	Density float64
+	// ConnectedComponents is the number of connected components. For a directed graph, this is
+	// the number of weakly connected components, i.e. the edge directions are ignored.
+	ConnectedComponents int
+	// StronglyConnectedComponents is the number of strongly connected components. For an
+	// undirected graph, this equals ConnectedComponents.
+	StronglyConnectedComponents int
+	// IsAcyclic reports whether the graph has no cycles. An undirected graph is acyclic if it is a
+	// forest. A self-loop is a cycle.
+	IsAcyclic bool
+	// MinDegree, MaxDegree, and AverageDegree describe the number of edges incident to each
+	// vertex, counting incoming as well as outgoing edges in a directed graph. A self-loop counts
+	// as a single incident edge.
+	MinDegree     int
+	MaxDegree     int
+	AverageDegree float64
+	// SelfLoops is the number of edges joining a vertex with itself.
+	SelfLoops int
+}
+
+// Stats computes a summary of common metrics of the graph, such as its density, the number of its
+// components, and its vertex degrees. All metrics are computed in linear time. Expensive metrics
+// like the diameter are not part of the summary and can be computed separately, e.g. using
+// DiameterPath.
+func Stats[K comparable, T any](g Graph[K, T]) (GraphStats, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return GraphStats{}, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	isDirected := g.Traits().IsDirected
+
+	stats := GraphStats{
+		Order: len(adjacencyMap),
+	}
+
+	components := newUnionFind[K]()
+	componentCount := len(adjacencyMap)
+
+	for _, edge := range sortedEdges(adjacencyMap, isDirected) {
+		stats.Size++
+
+		if edge.Source == edge.Target {
+			stats.SelfLoops++
+			continue
+		}
+
+		if components.union(edge.Source, edge.Target) {
+			componentCount--
+		}
+	}
+
+	stats.ConnectedComponents = componentCount
+
+	if n := float64(stats.Order); n > 1 {
+		possibleEdges := n * (n - 1)
+		if !isDirected {
+			possibleEdges /= 2
+		}
+		stats.Density = float64(stats.Size-stats.SelfLoops) / possibleEdges
+	}
+
+	if isDirected {
+		sccs, err := StronglyConnectedComponents(g)
+		if err != nil {
+			return GraphStats{}, fmt.Errorf("failed to get strongly connected components: %w", err)
+		}
+		stats.StronglyConnectedComponents = len(sccs)
+		stats.IsAcyclic = stats.SelfLoops == 0 && len(sccs) == stats.Order
+	} else {
+		stats.StronglyConnectedComponents = componentCount
+		stats.IsAcyclic = stats.SelfLoops == 0 && stats.Size == stats.Order-componentCount
+	}
+
+	if stats.Order == 0 {
+		return stats, nil
+	}
+
+	vertexDegrees := incidentEdges(adjacencyMap, isDirected)
+
+	stats.MinDegree = math.MaxInt
+	totalDegree := 0
+
+	for vertex := range adjacencyMap {
+		degree := vertexDegrees[vertex]
+		totalDegree += degree
+
+		if degree < stats.MinDegree {
+			stats.MinDegree = degree
+		}
+		if degree > stats.MaxDegree {
+			stats.MaxDegree = degree
+		}
+	}
+
+	stats.AverageDegree = float64(totalDegree) / float64(stats.Order)
+
+	return stats, nil
+}
+
+// incidentEdges returns the number of edges incident to each vertex, counting incoming as well as
+// outgoing edges in a directed graph. A self-loop counts as a single incident edge.
+func incidentEdges[K comparable](adjacencyMap map[K]map[K]Edge[K], isDirected bool) map[K]int {
+	if isDirected {
+		return degrees(adjacencyMap)
+	}
+
+	vertexDegrees := make(map[K]int, len(adjacencyMap))
+	for vertex, adjacencies := range adjacencyMap {
+		vertexDegrees[vertex] = len(adjacencies)
+	}
+
+	return vertexDegrees
+}
+
+// DegreeDistribution computes the degree distribution of the graph, i.e. how many vertices have a
+// given degree. The returned map contains the number of vertices for each degree that occurs in
+// the graph, and isolated vertices are counted under degree 0. The degree of a vertex is the number
+// of edges incident to it, counting incoming as well as outgoing edges in a directed graph. A
+// self-loop counts as a single incident edge.
+//
+// For directed graphs, InDegreeDistribution and OutDegreeDistribution only take the incoming and
+// outgoing edges into account, respectively.
+func DegreeDistribution[K comparable, T any](g Graph[K, T]) (map[int]int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	vertexDegrees := incidentEdges(adjacencyMap, g.Traits().IsDirected)
+	distribution := make(map[int]int)
+
+	for vertex := range adjacencyMap {
+		distribution[vertexDegrees[vertex]]++
+	}
+
+	return distribution, nil
+}
+
+// InDegreeDistribution computes the distribution of the in-degrees of the vertices like
+// DegreeDistribution does, where the in-degree of a vertex is its number of incoming edges. For an
+// undirected graph, the in-degree of a vertex equals its degree.
+func InDegreeDistribution[K comparable, T any](g Graph[K, T]) (map[int]int, error) {
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	return neighbourDistribution(predecessorMap), nil
+}
+
+// OutDegreeDistribution computes the distribution of the out-degrees of the vertices like
+// DegreeDistribution does, where the out-degree of a vertex is its number of outgoing edges. For an
+// undirected graph, the out-degree of a vertex equals its degree.
+func OutDegreeDistribution[K comparable, T any](g Graph[K, T]) (map[int]int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	return neighbourDistribution(adjacencyMap), nil
+}
+
+// neighbourDistribution counts the vertices by their number of neighbours in the given map.
+func neighbourDistribution[K comparable](neighbours map[K]map[K]Edge[K]) map[int]int {
+	distribution := make(map[int]int)
+
+	for _, adjacencies := range neighbours {
+		distribution[len(adjacencies)]++
+	}
+
+	return distribution
+}
+
+// PercolationThreshold simulates the random failure of edges and estimates the fraction of edges
+// that have to be present for the graph to stay connected as a whole. In each trial, the edges are
+// removed one after another in a random order until the graph fragments, i.e. until its largest
+// connected component contains less than half of the vertices. The fraction of edges still present
+// at that point is averaged over all trials.
+//
+// A low threshold means that the graph is robust against edge failures. If the graph is already
+// fragmented, the threshold is 1. If it never fragments, which is only the case for graphs with at
+// most two vertices, the threshold is 0. For a directed graph, the edge directions are ignored.
+//
+// The edges are shuffled using the given random number generator, so the same seed always yields
+// the same result. More trials lead to a more accurate estimate. The graph must have at least one
+// edge, and it is not modified.
+func PercolationThreshold[K comparable, T any](g Graph[K, T], rng *rand.Rand, trials int) (float64, error) {
+	if trials < 1 {
+		return 0, fmt.Errorf("number of trials must be positive, got %d", trials)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	edges := sortedEdges(adjacencyMap, g.Traits().IsDirected)
+	if len(edges) == 0 {
+		return 0, errors.New("percolation threshold requires at least one edge")
+	}
+
+	order := len(adjacencyMap)
+	total := 0.0
+
+	for trial := 0; trial < trials; trial++ {
+		rng.Shuffle(len(edges), func(i, j int) {
+			edges[i], edges[j] = edges[j], edges[i]
+		})
+
+		// Instead of removing the edges in their shuffled order, they are added in reverse order to
+		// an empty graph, which allows to track the largest component using a union-find. The graph
+		// fragments when the last edge whose addition connects half of the vertices is removed.
+		components := newUnionFind[K]()
+		sizes := make(map[K]int)
+		largest := 1
+		present := len(edges)
+
+		if 2*largest >= order {
+			present = 0
+		}
+
+		for i := len(edges) - 1; i >= 0 && present == len(edges); i-- {
+			sourceRoot := components.find(edges[i].Source)
+			targetRoot := components.find(edges[i].Target)
+
+			if !components.union(sourceRoot, targetRoot) {
+				continue
+			}
+
+			size := componentSize(sizes, sourceRoot) + componentSize(sizes, targetRoot)
+			sizes[components.find(sourceRoot)] = size
+
+			if size > largest {
+				largest = size
+			}
+
+			if 2*largest >= order {
+				present = len(edges) - i - 1
+			}
+		}
+
+		total += float64(present) / float64(len(edges))
+	}
+
+	return total / float64(trials), nil
+}
+
+// componentSize returns the size of the component with the given representative as recorded in
+// sizes, where components that haven't been recorded consist of a single vertex.
+func componentSize[K comparable](sizes map[K]int, root K) int {
+	if size, ok := sizes[root]; ok {
+		return size
+	}
+	return 1
+}
@@ -0,0 +1,945 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestDegreeAssortativity(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []int
+		edges         []Edge[int]
+		expectedValue float64
+		shouldFail    bool
+	}{
+		"undirected star graph": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expectedValue: -1,
+		},
+		"undirected path graph": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			expectedValue: -0.5,
+		},
+		"directed star graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 4, Target: 3},
+			},
+			// The source out-degrees are 2, 2, 1 and the target in-degrees are 1, 2, 2.
+			expectedValue: -0.5,
+		},
+		"undirected triangle with equal degrees": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			shouldFail: true,
+		},
+		"graph without edges": {
+			vertices:   []int{1, 2},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		value, err := DegreeAssortativity(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if math.Abs(value-test.expectedValue) > 1e-9 {
+			t.Errorf("%s: assortativity expectancy doesn't match: expected %v, got %v", name, test.expectedValue, value)
+		}
+	}
+}
+
+func TestCenterAndPeriphery(t *testing.T) {
+	tests := map[string]struct {
+		isDirected        bool
+		isWeighted        bool
+		vertices          []int
+		edges             []Edge[int]
+		expectedCenter    []int
+		expectedPeriphery []int
+		shouldFail        bool
+	}{
+		"undirected path": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			expectedCenter:    []int{3},
+			expectedPeriphery: []int{1, 5},
+		},
+		"weighted undirected path": {
+			isWeighted: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 10}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 1}},
+			},
+			expectedCenter:    []int{2},
+			expectedPeriphery: []int{1, 4},
+		},
+		"directed cycle": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedCenter:    []int{1, 2, 3},
+			expectedPeriphery: []int{1, 2, 3},
+		},
+		"disconnected graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			shouldFail: true,
+		},
+		"directed path": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		traits := []func(*Traits){}
+		if test.isDirected {
+			traits = append(traits, Directed())
+		}
+		if test.isWeighted {
+			traits = append(traits, Weighted())
+		}
+
+		graph := New(IntHash, traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		center, err := Center(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		periphery, err := Periphery(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !slicesAreEqual(center, test.expectedCenter) {
+			t.Errorf("%s: center expectancy doesn't match: expected %v, got %v", name, test.expectedCenter, center)
+		}
+
+		if !slicesAreEqual(periphery, test.expectedPeriphery) {
+			t.Errorf("%s: periphery expectancy doesn't match: expected %v, got %v", name, test.expectedPeriphery, periphery)
+		}
+	}
+}
+
+func TestDiameterPath(t *testing.T) {
+	tests := map[string]struct {
+		traits         []func(*Traits)
+		vertices       []int
+		edges          []Edge[int]
+		expectedPaths  [][]int
+		expectedLength int
+		shouldFail     bool
+	}{
+		"undirected path": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			expectedPaths:  [][]int{{1, 2, 3, 4, 5}},
+			expectedLength: 4,
+		},
+		"weighted undirected graph": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 10}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 1}},
+				{Source: 1, Target: 4, Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedPaths:  [][]int{{1, 4, 3, 2}},
+			expectedLength: 7,
+		},
+		"directed cycle": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedPaths:  [][]int{{1, 2, 3}},
+			expectedLength: 2,
+		},
+		"weighted tree": {
+			traits:   []func(*Traits){Tree(), Weighted()},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 4}},
+				{Source: 2, Target: 4, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 5, Properties: EdgeProperties{Weight: 7}},
+				{Source: 3, Target: 6, Properties: EdgeProperties{Weight: 1}},
+			},
+			expectedPaths:  [][]int{{5, 2, 1, 3, 6}, {6, 3, 1, 2, 5}},
+			expectedLength: 13,
+		},
+		"single vertex": {
+			vertices:       []int{1},
+			expectedPaths:  [][]int{{1}},
+			expectedLength: 0,
+		},
+		"empty graph": {
+			expectedPaths:  [][]int{{}},
+			expectedLength: 0,
+		},
+		"disconnected graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			shouldFail: true,
+		},
+		"disconnected tree": {
+			traits:   []func(*Traits){Tree()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash, test.traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		path, length, err := DiameterPath(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			if !errors.Is(err, ErrTargetNotReachable) {
+				t.Errorf("%s: error expectancy doesn't match: expected %v, got %v", name, ErrTargetNotReachable, err)
+			}
+			continue
+		}
+
+		matched := false
+		for _, expectedPath := range test.expectedPaths {
+			if orderedSlicesAreEqual(path, expectedPath) {
+				matched = true
+			}
+		}
+
+		if !matched {
+			t.Errorf("%s: path expectancy doesn't match: expected one of %v, got %v", name, test.expectedPaths, path)
+		}
+
+		if length != test.expectedLength {
+			t.Errorf("%s: length expectancy doesn't match: expected %v, got %v", name, test.expectedLength, length)
+		}
+	}
+}
+
+func TestWienerIndex(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		vertices      []int
+		edges         []Edge[int]
+		expectedIndex int
+		shouldFail    bool
+	}{
+		"undirected path": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			expectedIndex: 10,
+		},
+		"star": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 1, Target: 5},
+			},
+			expectedIndex: 16,
+		},
+		"weighted undirected triangle": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedIndex: 6,
+		},
+		"unweighted graph with edge weights": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedIndex: 1,
+		},
+		"directed cycle": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedIndex: 9,
+		},
+		"single vertex": {
+			vertices:      []int{1},
+			expectedIndex: 0,
+		},
+		"empty graph": {
+			expectedIndex: 0,
+		},
+		"disconnected graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			shouldFail: true,
+		},
+		"directed graph that isn't strongly connected": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash, test.traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		index, err := WienerIndex(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			if !errors.Is(err, ErrTargetNotReachable) {
+				t.Errorf("%s: error expectancy doesn't match: expected %v, got %v", name, ErrTargetNotReachable, err)
+			}
+			continue
+		}
+
+		if index != test.expectedIndex {
+			t.Errorf("%s: index expectancy doesn't match: expected %v, got %v", name, test.expectedIndex, index)
+		}
+	}
+}
+
+func TestTriangleCount(t *testing.T) {
+	tests := map[string]struct {
+		isDirected      bool
+		vertices        []int
+		edges           []Edge[int]
+		expectedCount   int
+		expectedThrough map[int]int
+		shouldFail      bool
+	}{
+		"complete graph with 4 vertices": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expectedCount:   4,
+			expectedThrough: map[int]int{1: 3, 2: 3, 3: 3, 4: 3},
+		},
+		"triangle with tail and self-loop": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 4},
+				{Source: 1, Target: 1},
+			},
+			expectedCount:   1,
+			expectedThrough: map[int]int{1: 1, 2: 1, 3: 1, 4: 0},
+		},
+		"cycle with 4 vertices": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			expectedCount:   0,
+			expectedThrough: map[int]int{1: 0, 2: 0, 3: 0, 4: 0},
+		},
+		"empty graph": {
+			expectedCount:   0,
+			expectedThrough: map[int]int{},
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		count, err := TriangleCount(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			if _, err := TrianglesThrough(graph, 1); err == nil {
+				t.Errorf("%s: per-vertex error expectancy doesn't match: expected an error, got nil", name)
+			}
+			continue
+		}
+
+		if count != test.expectedCount {
+			t.Errorf("%s: count expectancy doesn't match: expected %v, got %v", name, test.expectedCount, count)
+		}
+
+		for vertex, expected := range test.expectedThrough {
+			through, err := TrianglesThrough(graph, vertex)
+			if err != nil {
+				t.Fatalf("%s: unexpected error for vertex %v: %s", name, vertex, err.Error())
+			}
+
+			if through != expected {
+				t.Errorf("%s: count expectancy of %v doesn't match: expected %v, got %v", name, vertex, expected, through)
+			}
+		}
+	}
+}
+
+func TestTrianglesThrough_UnknownVertex(t *testing.T) {
+	graph := New(IntHash)
+	_ = graph.AddVertex(1)
+
+	if _, err := TrianglesThrough(graph, 2); err == nil {
+		t.Error("error expectancy doesn't match: expected an error, got nil")
+	}
+}
+
+// TestTriangleCount_Random compares the triangle counts of random graphs with the number of
+// pairwise adjacent triples of vertices.
+func TestTriangleCount_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for round := 0; round < 30; round++ {
+		n := 1 + rng.Intn(15)
+		graph := New(IntHash)
+
+		for i := 0; i < n; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		for i := 0; i < 3*n; i++ {
+			_ = graph.AddEdge(rng.Intn(n), rng.Intn(n))
+		}
+
+		expected := 0
+		through := make(map[int]int)
+
+		for a := 0; a < n; a++ {
+			for b := a + 1; b < n; b++ {
+				for c := b + 1; c < n; c++ {
+					if graph.HasEdge(a, b) && graph.HasEdge(b, c) && graph.HasEdge(a, c) {
+						expected++
+						through[a]++
+						through[b]++
+						through[c]++
+					}
+				}
+			}
+		}
+
+		count, err := TriangleCount(graph)
+		if err != nil {
+			t.Fatalf("round %d: unexpected error: %s", round, err.Error())
+		}
+
+		if count != expected {
+			t.Errorf("round %d: count expectancy doesn't match: expected %v, got %v", round, expected, count)
+		}
+
+		for vertex := 0; vertex < n; vertex++ {
+			if actual, _ := TrianglesThrough(graph, vertex); actual != through[vertex] {
+				t.Errorf("round %d: count expectancy of %v doesn't match: expected %v, got %v", round, vertex, through[vertex], actual)
+			}
+		}
+	}
+}
+
+func TestStats(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		vertices   []int
+		edges      []Edge[int]
+		expected   GraphStats
+	}{
+		"undirected tree": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expected: GraphStats{
+				Order:                       4,
+				Size:                        3,
+				Density:                     0.5,
+				ConnectedComponents:         1,
+				StronglyConnectedComponents: 1,
+				IsAcyclic:                   true,
+				MinDegree:                   1,
+				MaxDegree:                   3,
+				AverageDegree:               1.5,
+			},
+		},
+		"undirected graph with cycle, self-loop, and isolated vertex": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 4, Target: 4},
+			},
+			expected: GraphStats{
+				Order:                       5,
+				Size:                        4,
+				Density:                     0.3,
+				ConnectedComponents:         3,
+				StronglyConnectedComponents: 3,
+				IsAcyclic:                   false,
+				MinDegree:                   0,
+				MaxDegree:                   2,
+				AverageDegree:               1.4,
+				SelfLoops:                   1,
+			},
+		},
+		"directed graph with cycle": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+			},
+			expected: GraphStats{
+				Order:                       4,
+				Size:                        3,
+				Density:                     0.25,
+				ConnectedComponents:         2,
+				StronglyConnectedComponents: 3,
+				IsAcyclic:                   false,
+				MinDegree:                   0,
+				MaxDegree:                   3,
+				AverageDegree:               1.5,
+			},
+		},
+		"directed acyclic graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+			},
+			expected: GraphStats{
+				Order:                       3,
+				Size:                        3,
+				Density:                     0.5,
+				ConnectedComponents:         1,
+				StronglyConnectedComponents: 3,
+				IsAcyclic:                   true,
+				MinDegree:                   2,
+				MaxDegree:                   2,
+				AverageDegree:               2,
+			},
+		},
+		"empty graph": {
+			expected: GraphStats{
+				IsAcyclic: true,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		stats, err := Stats(graph)
+		if err != nil {
+			t.Fatalf("%s: failed to compute stats: %s", name, err.Error())
+		}
+
+		if math.Abs(stats.Density-test.expected.Density) > 1e-9 {
+			t.Errorf("%s: density expectancy doesn't match: expected %v, got %v", name, test.expected.Density, stats.Density)
+		}
+
+		stats.Density = test.expected.Density
+
+		if stats != test.expected {
+			t.Errorf("%s: stats expectancy doesn't match: expected %+v, got %+v", name, test.expected, stats)
+		}
+	}
+}
+
+func TestDegreeDistribution(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []int
+		edges         []Edge[int]
+		expectedTotal map[int]int
+		expectedIn    map[int]int
+		expectedOut   map[int]int
+	}{
+		"undirected star with isolated vertex": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expectedTotal: map[int]int{0: 1, 1: 3, 3: 1},
+			expectedIn:    map[int]int{0: 1, 1: 3, 3: 1},
+			expectedOut:   map[int]int{0: 1, 1: 3, 3: 1},
+		},
+		"directed graph with self-loop": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 3},
+			},
+			expectedTotal: map[int]int{0: 1, 2: 2, 3: 1},
+			expectedIn:    map[int]int{0: 2, 1: 1, 3: 1},
+			expectedOut:   map[int]int{0: 1, 1: 2, 2: 1},
+		},
+		"empty graph": {
+			expectedTotal: map[int]int{},
+			expectedIn:    map[int]int{},
+			expectedOut:   map[int]int{},
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		for kind, distributionFunc := range map[string]func(Graph[int, int]) (map[int]int, error){
+			"total": DegreeDistribution[int, int],
+			"in":    InDegreeDistribution[int, int],
+			"out":   OutDegreeDistribution[int, int],
+		} {
+			expected := map[string]map[int]int{
+				"total": test.expectedTotal,
+				"in":    test.expectedIn,
+				"out":   test.expectedOut,
+			}[kind]
+
+			distribution, err := distributionFunc(graph)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %s", name, err.Error())
+			}
+
+			if len(distribution) != len(expected) {
+				t.Fatalf("%s: %s degree distribution doesn't match: expected %v, got %v", name, kind, expected, distribution)
+			}
+
+			for degree, expectedCount := range expected {
+				if count := distribution[degree]; count != expectedCount {
+					t.Errorf("%s: %s degree count for degree %v doesn't match: expected %v, got %v", name, kind, degree, expectedCount, count)
+				}
+			}
+		}
+	}
+}
+
+func TestPercolationThreshold(t *testing.T) {
+	tests := map[string]struct {
+		isDirected        bool
+		vertices          []int
+		edges             []Edge[int]
+		trials            int
+		expectedThreshold float64
+		shouldFail        bool
+	}{
+		"star graph": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 1, Target: 5},
+			},
+			trials:            10,
+			expectedThreshold: 0.25,
+		},
+		"directed cycle": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			trials:            10,
+			expectedThreshold: 0,
+		},
+		"already fragmented graph": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 4},
+			},
+			trials:            10,
+			expectedThreshold: 1,
+		},
+		"graph that never fragments": {
+			vertices:          []int{1, 2},
+			edges:             []Edge[int]{{Source: 1, Target: 2}},
+			trials:            10,
+			expectedThreshold: 0,
+		},
+		"graph without edges": {
+			vertices:   []int{1, 2, 3},
+			trials:     10,
+			shouldFail: true,
+		},
+		"no trials": {
+			vertices:   []int{1, 2},
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			trials:     0,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		threshold, err := PercolationThreshold(graph, rand.New(rand.NewSource(1)), test.trials)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if math.Abs(threshold-test.expectedThreshold) > 1e-9 {
+			t.Errorf("%s: threshold expectancy doesn't match: expected %v, got %v", name, test.expectedThreshold, threshold)
+		}
+	}
+}
+
+func TestPercolationThreshold_Reproducible(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	graph := New(IntHash)
+
+	for i := 0; i < 30; i++ {
+		_ = graph.AddVertex(i)
+	}
+
+	for i := 0; i < 60; i++ {
+		_ = graph.AddEdge(rng.Intn(30), rng.Intn(30))
+	}
+
+	first, err := PercolationThreshold(graph, rand.New(rand.NewSource(42)), 20)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	second, err := PercolationThreshold(graph, rand.New(rand.NewSource(42)), 20)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if first != second {
+		t.Errorf("thresholds for the same seed don't match: %v and %v", first, second)
+	}
+
+	if first <= 0 || first >= 1 {
+		t.Errorf("threshold out of range: %v", first)
+	}
+}
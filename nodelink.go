@@ -0,0 +1,196 @@
+package graph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// nodeLinkData is the node-link representation of a graph as produced by NetworkX's
+// node_link_data function. The links are maps so that edge attributes can be stored as extra
+// fields next to the source, target, and weight fields.
+type nodeLinkData[K comparable] struct {
+	Directed   bool                     `json:"directed"`
+	Multigraph bool                     `json:"multigraph"`
+	Graph      map[string]interface{}   `json:"graph"`
+	Nodes      []nodeLinkNode[K]        `json:"nodes"`
+	Links      []map[string]interface{} `json:"links"`
+}
+
+type nodeLinkNode[K comparable] struct {
+	ID K `json:"id"`
+}
+
+// ExportNodeLinkJSON writes the given graph as node-link JSON into an io.Writer. This is the format
+// produced by NetworkX's node_link_data function, so the output can be read in Python using
+// networkx.node_link_graph:
+//
+//	{
+//		"directed": true,
+//		"multigraph": false,
+//		"graph": {},
+//		"nodes": [{"id": 1}, {"id": 2}],
+//		"links": [{"source": 1, "target": 2, "weight": 5, "color": "red"}]
+//	}
+//
+// The vertex hashes are used as node IDs and thus have to be encodable by encoding/json. Links
+// only have a weight field if the graph is weighted. Edge attributes are written as extra string
+// fields of the link, except for attributes named source, target, or weight, which are omitted.
+// Nodes and links are written in ascending order of their hashes. For an undirected graph, each
+// edge is written once.
+func ExportNodeLinkJSON[K comparable, T any](g Graph[K, T], w io.Writer) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sortKeys(vertices)
+
+	data := nodeLinkData[K]{
+		Directed: g.Traits().IsDirected,
+		Graph:    make(map[string]interface{}),
+		Nodes:    make([]nodeLinkNode[K], 0, len(vertices)),
+		Links:    make([]map[string]interface{}, 0),
+	}
+
+	for _, vertex := range vertices {
+		data.Nodes = append(data.Nodes, nodeLinkNode[K]{ID: vertex})
+	}
+
+	for _, edge := range sortedEdges(adjacencyMap, g.Traits().IsDirected) {
+		link := make(map[string]interface{}, len(edge.Properties.Attributes)+3)
+
+		for key, value := range edge.Properties.Attributes {
+			link[key] = value
+		}
+
+		link["source"] = edge.Source
+		link["target"] = edge.Target
+
+		if g.Traits().IsWeighted {
+			link["weight"] = edge.Properties.Weight
+		} else {
+			delete(link, "weight")
+		}
+
+		data.Links = append(data.Links, link)
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		return fmt.Errorf("failed to encode node-link data: %w", err)
+	}
+
+	return nil
+}
+
+// ImportNodeLinkJSON reads a graph in the node-link JSON format produced by ExportNodeLinkJSON or
+// NetworkX's node_link_data function from the given io.Reader. The node IDs are decoded into the
+// hash type K, and each vertex is its own hash. Links may be listed under the key "links" or, as
+// done by newer NetworkX versions, under the key "edges".
+//
+// The resulting graph is directed if the directed field is true, and it is weighted if any link
+// has a weight field. Weights must be integers. All other link fields are stored as edge
+// attributes: String values are stored as they are, all other values are stored as their JSON
+// representation. Multigraphs and links between unknown nodes are rejected with an error.
+func ImportNodeLinkJSON[K comparable](r io.Reader) (Graph[K, K], error) {
+	var data struct {
+		Directed   bool                         `json:"directed"`
+		Multigraph bool                         `json:"multigraph"`
+		Nodes      []nodeLinkNode[K]            `json:"nodes"`
+		Links      []map[string]json.RawMessage `json:"links"`
+		Edges      []map[string]json.RawMessage `json:"edges"`
+	}
+
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode node-link data: %w", err)
+	}
+
+	if data.Multigraph {
+		return nil, errors.New("multigraphs are not supported")
+	}
+
+	links := append(data.Links, data.Edges...)
+
+	isWeighted := false
+	for _, link := range links {
+		if _, ok := link["weight"]; ok {
+			isWeighted = true
+			break
+		}
+	}
+
+	var options []func(*Traits)
+	if data.Directed {
+		options = append(options, Directed())
+	}
+	if isWeighted {
+		options = append(options, Weighted())
+	}
+
+	g := New(func(k K) K { return k }, options...)
+
+	for _, node := range data.Nodes {
+		if err := g.AddVertex(node.ID); err != nil {
+			return nil, fmt.Errorf("failed to add node %v: %w", node.ID, err)
+		}
+	}
+
+	for i, link := range links {
+		var source, target K
+
+		if err := decodeLinkField(link, "source", &source); err != nil {
+			return nil, fmt.Errorf("link %d: %w", i, err)
+		}
+
+		if err := decodeLinkField(link, "target", &target); err != nil {
+			return nil, fmt.Errorf("link %d: %w", i, err)
+		}
+
+		edgeOptions := make([]func(*EdgeProperties), 0, len(link))
+
+		for key, value := range link {
+			switch key {
+			case "source", "target":
+				continue
+			case "weight":
+				var weight float64
+				if err := json.Unmarshal(value, &weight); err != nil || weight != math.Trunc(weight) {
+					return nil, fmt.Errorf("link %d: weight %s is not an integer", i, value)
+				}
+				edgeOptions = append(edgeOptions, EdgeWeight(int(weight)))
+			default:
+				var text string
+				if err := json.Unmarshal(value, &text); err != nil {
+					text = string(value)
+				}
+				edgeOptions = append(edgeOptions, EdgeAttribute(key, text))
+			}
+		}
+
+		if err := g.AddEdge(source, target, edgeOptions...); err != nil {
+			return nil, fmt.Errorf("link %d: failed to add edge (%v, %v): %w", i, source, target, err)
+		}
+	}
+
+	return g, nil
+}
+
+func decodeLinkField[K comparable](link map[string]json.RawMessage, key string, value *K) error {
+	raw, ok := link[key]
+	if !ok {
+		return fmt.Errorf("missing %s field", key)
+	}
+
+	if err := json.Unmarshal(raw, value); err != nil {
+		return fmt.Errorf("invalid %s field %s: %w", key, raw, err)
+	}
+
+	return nil
+}
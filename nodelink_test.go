@@ -0,0 +1,208 @@
+package graph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestExportNodeLinkJSON(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		isWeighted bool
+		vertices   []int
+		edges      []Edge[int]
+		expected   string
+	}{
+		"directed weighted graph with attributes": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{2, 1, 3},
+			edges: []Edge[int]{
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 4}},
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5, Attributes: map[string]string{"color": "red"}}},
+			},
+			expected: `{"directed":true,"multigraph":false,"graph":{},"nodes":[{"id":1},{"id":2},{"id":3}],"links":[{"color":"red","source":1,"target":2,"weight":5},{"source":2,"target":3,"weight":4}]}`,
+		},
+		"undirected unweighted graph": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 2, Target: 1},
+			},
+			expected: `{"directed":false,"multigraph":false,"graph":{},"nodes":[{"id":1},{"id":2}],"links":[{"source":1,"target":2}]}`,
+		},
+		"empty graph": {
+			expected: `{"directed":false,"multigraph":false,"graph":{},"nodes":[],"links":[]}`,
+		},
+	}
+
+	for name, test := range tests {
+		traits := []func(*Traits){}
+		if test.isDirected {
+			traits = append(traits, Directed())
+		}
+		if test.isWeighted {
+			traits = append(traits, Weighted())
+		}
+
+		graph := New(IntHash, traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			options := []func(*EdgeProperties){EdgeWeight(edge.Properties.Weight)}
+			for key, value := range edge.Properties.Attributes {
+				options = append(options, EdgeAttribute(key, value))
+			}
+			if err := graph.AddEdge(edge.Source, edge.Target, options...); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		var buf bytes.Buffer
+		if err := ExportNodeLinkJSON(graph, &buf); err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if output := strings.TrimSpace(buf.String()); output != test.expected {
+			t.Errorf("%s: output expectancy doesn't match: expected %v, got %v", name, test.expected, output)
+		}
+	}
+}
+
+func TestImportNodeLinkJSON(t *testing.T) {
+	tests := map[string]struct {
+		input              string
+		expectedIsDirected bool
+		expectedIsWeighted bool
+		expectedVertices   []string
+		expectedEdges      []Edge[string]
+		shouldFail         bool
+	}{
+		"NetworkX output": {
+			input: `{"directed": true, "multigraph": false, "graph": {"name": "g"},
+				"nodes": [{"id": "a"}, {"id": "b"}, {"id": "c"}],
+				"links": [
+					{"source": "a", "target": "b", "weight": 2.0, "color": "red"},
+					{"source": "b", "target": "c", "weight": 3, "capacity": 10}
+				]}`,
+			expectedIsDirected: true,
+			expectedIsWeighted: true,
+			expectedVertices:   []string{"a", "b", "c"},
+			expectedEdges: []Edge[string]{
+				{Source: "a", Target: "b", Properties: EdgeProperties{Weight: 2, Attributes: map[string]string{"color": "red"}}},
+				{Source: "b", Target: "c", Properties: EdgeProperties{Weight: 3, Attributes: map[string]string{"capacity": "10"}}},
+			},
+		},
+		"edges key": {
+			input: `{"directed": false, "nodes": [{"id": "a"}, {"id": "b"}],
+				"edges": [{"source": "a", "target": "b"}]}`,
+			expectedVertices: []string{"a", "b"},
+			expectedEdges: []Edge[string]{
+				{Source: "b", Target: "a", Properties: EdgeProperties{Attributes: map[string]string{}}},
+			},
+		},
+		"multigraph": {
+			input:      `{"directed": false, "multigraph": true, "nodes": [], "links": []}`,
+			shouldFail: true,
+		},
+		"fractional weight": {
+			input:      `{"nodes": [{"id": "a"}, {"id": "b"}], "links": [{"source": "a", "target": "b", "weight": 1.5}]}`,
+			shouldFail: true,
+		},
+		"unknown node": {
+			input:      `{"nodes": [{"id": "a"}], "links": [{"source": "a", "target": "b"}]}`,
+			shouldFail: true,
+		},
+		"missing target": {
+			input:      `{"nodes": [{"id": "a"}], "links": [{"source": "a"}]}`,
+			shouldFail: true,
+		},
+		"invalid JSON": {
+			input:      `{"nodes": [`,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph, err := ImportNodeLinkJSON[string](strings.NewReader(test.input))
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if graph.Traits().IsDirected != test.expectedIsDirected {
+			t.Errorf("%s: directedness expectancy doesn't match: expected %v, got %v", name, test.expectedIsDirected, graph.Traits().IsDirected)
+		}
+
+		if graph.Traits().IsWeighted != test.expectedIsWeighted {
+			t.Errorf("%s: weightedness expectancy doesn't match: expected %v, got %v", name, test.expectedIsWeighted, graph.Traits().IsWeighted)
+		}
+
+		if graph.Order() != len(test.expectedVertices) {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, len(test.expectedVertices), graph.Order())
+		}
+
+		if graph.Size() != len(test.expectedEdges) {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), graph.Size())
+		}
+
+		for _, expectedEdge := range test.expectedEdges {
+			edge, err := graph.Edge(expectedEdge.Source, expectedEdge.Target)
+			if err != nil {
+				t.Fatalf("%s: edge (%v, %v) expected", name, expectedEdge.Source, expectedEdge.Target)
+			}
+
+			if !propertiesAreEqual(edge.Properties, expectedEdge.Properties) {
+				t.Errorf("%s: edge properties expectancy doesn't match: expected %v, got %v", name, expectedEdge.Properties, edge.Properties)
+			}
+		}
+	}
+}
+
+func TestNodeLinkJSONRoundTrip(t *testing.T) {
+	graph := New(StringHash, Directed(), Weighted())
+
+	for _, vertex := range []string{"a", "b", "c"} {
+		_ = graph.AddVertex(vertex)
+	}
+
+	_ = graph.AddEdge("a", "b", EdgeWeight(3), EdgeAttribute("label", "ab"))
+	_ = graph.AddEdge("c", "a", EdgeWeight(-1))
+
+	var buf bytes.Buffer
+	if err := ExportNodeLinkJSON(graph, &buf); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	imported, err := ImportNodeLinkJSON[string](&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	expectedAdjacencyMap, _ := graph.AdjacencyMap()
+	adjacencyMap, _ := imported.AdjacencyMap()
+
+	if len(adjacencyMap) != len(expectedAdjacencyMap) {
+		t.Fatalf("order expectancy doesn't match: expected %v, got %v", len(expectedAdjacencyMap), len(adjacencyMap))
+	}
+
+	for source, adjacencies := range expectedAdjacencyMap {
+		for target, expectedEdge := range adjacencies {
+			edge, ok := adjacencyMap[source][target]
+			if !ok {
+				t.Fatalf("edge (%v, %v) expected", source, target)
+			}
+
+			if !propertiesAreEqual(edge.Properties, expectedEdge.Properties) {
+				t.Errorf("edge properties expectancy doesn't match: expected %v, got %v", expectedEdge.Properties, edge.Properties)
+			}
+		}
+	}
+}
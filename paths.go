@@ -65,74 +65,609 @@ func CreatesCycle[K comparable, T any](g Graph[K, T], source, target K) (bool, e
 	return false, nil
 }
 
+// newCycleError creates a *CycleError for an edge between the given source and target vertex that
+// would introduce a cycle. The cycle is determined by finding a path from the target back to the
+// source, which is then closed by the edge.
+func newCycleError[K comparable, T any](g Graph[K, T], source, target K) *CycleError[K] {
+	cycleErr := &CycleError[K]{
+		Source: source,
+		Target: target,
+		cycle:  []K{source},
+	}
+
+	if source == target {
+		return cycleErr
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return cycleErr
+	}
+
+	predecessors := map[K]K{target: target}
+	queue := []K{target}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == source {
+			break
+		}
+
+		for adjacency := range adjacencyMap[current] {
+			if _, ok := predecessors[adjacency]; !ok {
+				predecessors[adjacency] = current
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	if _, ok := predecessors[source]; !ok {
+		return cycleErr
+	}
+
+	// Backtrack the path from the source to the target, which yields the vertices in reverse order,
+	// i.e. in the order in which they follow the source vertex in the cycle.
+	path := make([]K, 0)
+
+	for current := source; current != target; {
+		current = predecessors[current]
+		path = append(path, current)
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		cycleErr.cycle = append(cycleErr.cycle, path[i])
+	}
+
+	return cycleErr
+}
+
 // ShortestPath computes the shortest path between a source and a target vertex using the edge
 // weights and returns the hash values of the vertices forming that path. This search runs in
 // O(|V|+|E|log(|V|)) time.
 //
-// The returned path includes the source and target vertices. If the target cannot be reached
-// from the source vertex, ShortestPath returns an error. If there are multiple shortest paths,
-// an arbitrary one will be returned.
+// The returned path includes the source and target vertices. If the target cannot be reached from
+// the source vertex, an error wrapping ErrTargetNotReachable will be returned. If there are multiple
+// shortest paths, an arbitrary one will be returned. Use ShortestPathFunc to choose between them
+// deterministically, and ShortestPathWithWeight to optimize a weight other than the stored edge
+// weight.
 func ShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, error) {
-	weights := make(map[K]float64)
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	paths := dijkstra(adjacencyMap, source, IntWeight[K](), nil)
+
+	if _, ok := paths.distances[target]; !ok {
+		return nil, fmt.Errorf("%w: %v -> %v", ErrTargetNotReachable, source, target)
+	}
+
+	// Backtrack the predecessors from target to source. These are the least-weighted edges.
+	path := []K{target}
+	for current := target; current != source; {
+		current = paths.predecessors[current]
+		path = append(path, current)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, nil
+}
+
+// ShortestPathDistance computes the total weight of the shortest path between a source and a
+// target vertex like ShortestPath does, but doesn't reconstruct the path itself. This avoids the
+// allocations for the path if only the distance is needed, e.g. in heuristics or metrics.
+//
+// If the target cannot be reached from the source vertex, an error wrapping ErrTargetNotReachable
+// will be returned.
+func ShortestPathDistance[K comparable, T any](g Graph[K, T], source, target K) (int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	paths := dijkstra(adjacencyMap, source, IntWeight[K](), nil)
+
+	distance, ok := paths.distances[target]
+	if !ok {
+		return 0, fmt.Errorf("%w: %v -> %v", ErrTargetNotReachable, source, target)
+	}
+
+	return distance, nil
+}
+
+// shortestPaths is the result of a single-source shortest path search performed by dijkstra.
+type shortestPaths[K comparable] struct {
+	// distances contains the distance of each reachable vertex from the source.
+	distances map[K]int
+	// predecessors contains the predecessor of each reachable vertex except for the source on a
+	// shortest path from the source.
+	predecessors map[K]K
+	// order contains the reachable vertices in the order in which they have been popped from the
+	// queue for the first time, which is the order of non-decreasing distance.
+	order []K
+}
+
+// dijkstra is the single-source shortest path search shared by ShortestPath, ShortestPathDistance,
+// and the functions based on singleSourceShortestPaths. Starting at the source vertex, it visits the
+// vertices in order of their distance using a PriorityQueue, where the length of each edge is
+// determined by the given weight function. Vertices that can't be reached from the source are not
+// contained in the result.
+//
+// If a vertex can be reached via multiple shortest paths, its predecessor is chosen arbitrarily. If
+// the less function is not nil, the smallest of all possible predecessors is chosen instead.
+func dijkstra[K comparable](adjacencyMap map[K]map[K]Edge[K], source K, weight func(Edge[K]) int, less func(a, b K) bool) shortestPaths[K] {
+	paths := shortestPaths[K]{
+		distances:    map[K]int{source: 0},
+		predecessors: make(map[K]K),
+		order:        make([]K, 0, len(adjacencyMap)),
+	}
+
 	visited := make(map[K]bool)
-	bestPredecessors := make(map[K]K)
 
-	weights[source] = 0
-	visited[target] = true
+	queue := &PriorityQueue[K]{}
+	queue.Push(source, 0)
+
+	for queue.Len() > 0 {
+		current, _ := queue.Pop()
 
-	queue := newPriorityQueue[K]()
+		if !visited[current] {
+			visited[current] = true
+			paths.order = append(paths.order, current)
+		}
+
+		for adjacency, edge := range adjacencyMap[current] {
+			distance := paths.distances[current] + weight(edge)
+			currentDistance, ok := paths.distances[adjacency]
+
+			switch {
+			case !ok || distance < currentDistance:
+				paths.distances[adjacency] = distance
+				paths.predecessors[adjacency] = current
+				queue.Push(adjacency, distance)
+			case less != nil && adjacency != source && !visited[adjacency] && distance == currentDistance && less(current, paths.predecessors[adjacency]):
+				paths.predecessors[adjacency] = current
+			}
+		}
+	}
+
+	return paths
+}
+
+// edgeDistance returns the weight function used by the shortest path functions that distinguish
+// between weighted and unweighted graphs: In a weighted graph, the length of an edge is its weight.
+// In an unweighted graph, each edge counts as one hop.
+func edgeDistance[K comparable](isWeighted bool) func(Edge[K]) int {
+	if !isWeighted {
+		return func(Edge[K]) int {
+			return 1
+		}
+	}
+
+	return IntWeight[K]()
+}
+
+// ShortestPathsFrom computes the shortest paths from a source vertex to each of the given target
+// vertices and returns them in a map keyed by the target. Each path includes the source and the
+// target vertex. Unlike calling ShortestPath for each target, ShortestPathsFrom runs only a single
+// search from the source vertex, which is shared by all targets.
+//
+// In a weighted graph, the edge weights must not be negative. In an unweighted graph, each edge
+// counts as one hop. Targets that cannot be reached from the source vertex are omitted from the
+// returned map. If the source or one of the targets doesn't exist, an error will be returned.
+func ShortestPathsFrom[K comparable, T any](g Graph[K, T], source K, targets []K) (map[K][]K, error) {
 	adjacencyMap, err := g.AdjacencyMap()
 	if err != nil {
 		return nil, fmt.Errorf("could not get adjacency map: %w", err)
 	}
 
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	for _, target := range targets {
+		if _, ok := adjacencyMap[target]; !ok {
+			return nil, fmt.Errorf("could not find target vertex with hash %v", target)
+		}
+	}
+
+	distances, predecessors := singleSourceShortestPaths(adjacencyMap, source, g.Traits().IsWeighted, nil)
+
+	paths := make(map[K][]K, len(targets))
+
+	for _, target := range targets {
+		if _, ok := distances[target]; !ok {
+			continue
+		}
+
+		path := []K{target}
+		for current := target; current != source; {
+			current = predecessors[current]
+			path = append(path, current)
+		}
+
+		for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+			path[i], path[j] = path[j], path[i]
+		}
+
+		paths[target] = path
+	}
+
+	return paths, nil
+}
+
+// ShortestPathFunc computes the shortest path between a source and a target vertex like
+// ShortestPath does, but if there are multiple shortest paths, it uses the given tieBreak function
+// to choose between them: Starting at the source, each step goes to the smallest next hop that
+// still lies on a shortest path to the target, where a is smaller than b if tieBreak(a, b) returns
+// true. For example, passing a function that compares strings yields the lexicographically
+// smallest shortest path.
+//
+// In a weighted graph, the edge weights must not be negative. In an unweighted graph, each edge
+// counts as one hop. If the target cannot be reached from the source vertex, an error wrapping
+// ErrTargetNotReachable will be returned.
+func ShortestPathFunc[K comparable, T any](g Graph[K, T], source, target K, tieBreak func(a, b K) bool) ([]K, error) {
 	predecessorMap, err := g.PredecessorMap()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
 	}
 
-	for hash := range adjacencyMap {
-		if hash != source {
-			weights[hash] = math.Inf(1)
-			visited[hash] = false
+	if _, ok := predecessorMap[source]; !ok {
+		return nil, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	if _, ok := predecessorMap[target]; !ok {
+		return nil, fmt.Errorf("could not find target vertex with hash %v", target)
+	}
+
+	// Searching backwards from the target yields the next hop towards the target for each vertex.
+	// Since the search prefers the smallest predecessor, this is the smallest possible next hop.
+	_, nextHops := singleSourceShortestPaths(predecessorMap, target, g.Traits().IsWeighted, tieBreak)
+
+	path := []K{source}
+
+	for current := source; current != target; {
+		next, ok := nextHops[current]
+		if !ok {
+			return nil, fmt.Errorf("%w: vertex %v is not reachable from vertex %v", ErrTargetNotReachable, target, source)
 		}
 
-		queue.Push(hash, weights[hash])
+		path = append(path, next)
+		current = next
+	}
+
+	return path, nil
+}
+
+// WidestPath computes the widest path between a source and a target vertex, i.e. the path whose
+// smallest edge weight is as large as possible, and returns it along with that smallest weight,
+// called the bottleneck. This is useful for routing with bandwidth or reliability constraints,
+// where the edge weights are capacities. If the graph is unweighted, each edge has a capacity of 1.
+//
+// The path is computed using a variant of Dijkstra's algorithm that maximizes the bottleneck
+// instead of minimizing the sum of the weights. The returned path includes the source and target
+// vertices. If the source and the target are the same vertex, the path has no edges and the
+// bottleneck is math.MaxInt. If the target cannot be reached from the source vertex, an error
+// wrapping ErrTargetNotReachable will be returned.
+func WidestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, 0, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	if _, ok := adjacencyMap[target]; !ok {
+		return nil, 0, fmt.Errorf("could not find target vertex with hash %v", target)
 	}
 
+	isWeighted := g.Traits().IsWeighted
+
+	bottlenecks := map[K]int{source: math.MaxInt}
+	predecessors := make(map[K]K)
+	settled := make(map[K]bool)
+
+	// The queue pops the smallest priority first, so the bottlenecks are negated.
+	queue := &PriorityQueue[K]{}
+	queue.Push(source, -math.MaxInt)
+
 	for queue.Len() > 0 {
-		vertex, _ := queue.Pop()
-		hasInfiniteWeight := math.IsInf(weights[vertex], 1)
+		current, _ := queue.Pop()
+		if current == target {
+			break
+		}
+		settled[current] = true
 
-		if vertex == target {
-			targetPredecessors := predecessorMap[target]
+		for adjacency, edge := range adjacencyMap[current] {
+			if settled[adjacency] {
+				continue
+			}
 
-			if len(targetPredecessors) == 0 {
-				return nil, fmt.Errorf("vertex %v is not reachable from vertex %v", target, source)
+			capacity := 1
+			if isWeighted {
+				capacity = edge.Properties.Weight
 			}
-		}
 
-		for adjacency, edge := range adjacencyMap[vertex] {
-			weight := weights[vertex] + float64(edge.Properties.Weight)
+			bottleneck := bottlenecks[current]
+			if capacity < bottleneck {
+				bottleneck = capacity
+			}
 
-			if weight < weights[adjacency] && !hasInfiniteWeight {
-				weights[adjacency] = weight
-				bestPredecessors[adjacency] = vertex
-				queue.DecreasePriority(adjacency, weight)
+			if existing, ok := bottlenecks[adjacency]; !ok || bottleneck > existing {
+				bottlenecks[adjacency] = bottleneck
+				predecessors[adjacency] = current
+				queue.Push(adjacency, -bottleneck)
 			}
 		}
 	}
 
-	// Backtrack the predecessors from target to source. These are the least-weighted edges.
+	bottleneck, ok := bottlenecks[target]
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: vertex %v is not reachable from vertex %v", ErrTargetNotReachable, target, source)
+	}
+
 	path := []K{target}
-	hashCursor := target
 
-	for hashCursor != source {
-		hashCursor = bestPredecessors[hashCursor]
-		path = append([]K{hashCursor}, path...)
+	for current := target; current != source; {
+		current = predecessors[current]
+		path = append([]K{current}, path...)
 	}
 
-	return path, nil
+	return path, bottleneck, nil
+}
+
+// IterativeDeepeningDFS finds the shallowest path between a source and a target vertex using an
+// iterative deepening depth-first search. It runs a depth-limited DFS with a depth limit of 0, 1,
+// 2, and so on, up to the given maximum depth, and returns the first path to the target it finds.
+//
+// Because the depth limit is increased one by one, the returned path has the fewest possible
+// edges. In contrast to a BFS, the memory consumption is linear in the depth, which makes this
+// search suitable for large graphs with a high branching factor. Edge weights are ignored.
+//
+// The returned path includes the source and target vertices. If the target cannot be reached
+// within the maximum depth, ErrTargetNotReachable will be returned.
+func IterativeDeepeningDFS[K comparable, T any](g Graph[K, T], source, target K, maxDepth int) ([]K, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	if _, ok := adjacencyMap[target]; !ok {
+		return nil, fmt.Errorf("could not find target vertex with hash %v", target)
+	}
+
+	for depth := 0; depth <= maxDepth; depth++ {
+		path := []K{source}
+		onPath := map[K]bool{source: true}
+
+		if depthLimitedSearch(adjacencyMap, target, depth, &path, onPath) {
+			return path, nil
+		}
+	}
+
+	return nil, ErrTargetNotReachable
+}
+
+// depthLimitedSearch extends the given path by a DFS of up to the given depth. It returns true if
+// the target has been found, in which case path ends with the target. Vertices on the current path
+// aren't visited again, which prevents the search from running in cycles.
+func depthLimitedSearch[K comparable](adjacencyMap map[K]map[K]Edge[K], target K, depth int, path *[]K, onPath map[K]bool) bool {
+	current := (*path)[len(*path)-1]
+
+	if current == target {
+		return true
+	}
+
+	if depth == 0 {
+		return false
+	}
+
+	for adjacency := range adjacencyMap[current] {
+		if onPath[adjacency] {
+			continue
+		}
+
+		*path = append(*path, adjacency)
+		onPath[adjacency] = true
+
+		if depthLimitedSearch(adjacencyMap, target, depth-1, path, onPath) {
+			return true
+		}
+
+		*path = (*path)[:len(*path)-1]
+		delete(onPath, adjacency)
+	}
+
+	return false
+}
+
+// shortestDistances computes the distances of all vertices reachable from the source vertex. If the
+// graph is weighted, the distances are the sums of the edge weights along the shortest paths, which
+// requires non-negative weights. Otherwise, each edge counts as one hop. Unreachable vertices are
+// not contained in the returned map.
+func shortestDistances[K comparable](adjacencyMap map[K]map[K]Edge[K], source K, weighted bool) map[K]float64 {
+	distances, _ := singleSourceShortestPaths(adjacencyMap, source, weighted, nil)
+	return distances
+}
+
+// singleSourceShortestPaths computes the distances of all vertices reachable from the source vertex
+// like shortestDistances does, and additionally returns the predecessor of each vertex on its
+// shortest path. The source vertex has no predecessor.
+//
+// If a vertex can be reached via multiple shortest paths, its predecessor is chosen arbitrarily. If
+// the less function is not nil, the smallest of all possible predecessors is chosen instead.
+func singleSourceShortestPaths[K comparable](adjacencyMap map[K]map[K]Edge[K], source K, weighted bool, less func(a, b K) bool) (map[K]float64, map[K]K) {
+	paths := dijkstra(adjacencyMap, source, edgeDistance[K](weighted), less)
+
+	distances := make(map[K]float64, len(paths.distances))
+	for vertex, distance := range paths.distances {
+		distances[vertex] = float64(distance)
+	}
+
+	return distances, paths.predecessors
+}
+
+// ShortestPathTree computes the shortest path tree rooted at the given source vertex and returns it
+// as a new graph. The tree contains the source vertex and all vertices reachable from it, and for
+// each of these vertices, it contains the last edge of the shortest path from the source to that
+// vertex. Thus, the path from the source to any vertex in the tree is a shortest path in the graph.
+//
+// In a weighted graph, the shortest paths are determined using Dijkstra's algorithm and the edge
+// weights, which must not be negative. In an unweighted graph, each edge counts as one hop. If a
+// vertex can be reached via multiple shortest paths, one of them is chosen arbitrarily. Use
+// ShortestPathTreeFunc to break these ties deterministically.
+//
+// The tree edges keep their weights and attributes, and the tree has the same traits as the graph.
+func ShortestPathTree[K comparable, T any](g Graph[K, T], source K) (Graph[K, T], error) {
+	return ShortestPathTreeFunc(g, source, nil)
+}
+
+// ShortestPathTreeFunc works like ShortestPathTree, but if a vertex can be reached via multiple
+// shortest paths, it chooses the path whose last hop comes from the smallest predecessor according
+// to the given less function. This makes the resulting tree deterministic.
+func ShortestPathTreeFunc[K comparable, T any](g Graph[K, T], source K, less func(a, b K) bool) (Graph[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	_, predecessors := singleSourceShortestPaths(adjacencyMap, source, g.Traits().IsWeighted, less)
+
+	tree, err := newLike(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tree: %w", err)
+	}
+
+	if err := addVertexFrom(tree, g, source); err != nil {
+		return nil, err
+	}
+
+	for vertex := range predecessors {
+		if err := addVertexFrom(tree, g, vertex); err != nil {
+			return nil, err
+		}
+	}
+
+	for vertex, predecessor := range predecessors {
+		edge := adjacencyMap[predecessor][vertex]
+		if err := tree.AddEdge(predecessor, vertex, copyEdgeProperties(edge.Properties)); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", predecessor, vertex, err)
+		}
+	}
+
+	return tree, nil
+}
+
+// UnweightedDistances computes the number of edges on the shortest path from the source vertex to
+// each vertex reachable from it using a breadth-first search. Edge weights are ignored, even if the
+// graph is weighted. The source vertex has a distance of 0, and vertices that aren't reachable
+// from the source vertex are not contained in the returned map.
+func UnweightedDistances[K comparable, T any](g Graph[K, T], source K) (map[K]int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	distances := map[K]int{source: 0}
+	queue := []K{source}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for adjacency := range adjacencyMap[current] {
+			if _, ok := distances[adjacency]; ok {
+				continue
+			}
+
+			distances[adjacency] = distances[current] + 1
+			queue = append(queue, adjacency)
+		}
+	}
+
+	return distances, nil
+}
+
+// MetricClosure computes the metric closure of the graph with respect to the given terminal
+// vertices. The metric closure is a complete graph on the terminals in which each edge weight is
+// the shortest path distance between the two terminals in the original graph. It is a common
+// building block for approximating Steiner trees or the traveling salesman problem.
+//
+// If the graph is weighted, the distances are the sums of the edge weights along the shortest
+// paths, which requires non-negative weights. Otherwise, each edge counts as one hop. For a
+// directed graph, the closure is directed as well and contains an edge from each terminal to each
+// other terminal. The closure is always weighted and never acyclic or rooted, and its vertices are
+// the vertex values of the terminals.
+//
+// The closure is returned as a Graph[K, T] rather than a Graph[K, int]: It has to identify the
+// terminals by the same hashes as g, which requires the hashing function of g and thus vertices of
+// type T. The distances are available as the int edge weights of the closure.
+//
+// If a terminal doesn't exist, an error will be returned. If a terminal cannot reach another
+// terminal, an error wrapping ErrTargetNotReachable will be returned.
+func MetricClosure[K comparable, T any](g Graph[K, T], terminals []K) (Graph[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	closure, err := newLike(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create closure: %w", err)
+	}
+
+	closure.Traits().IsWeighted = true
+	closure.Traits().IsAcyclic = false
+	closure.Traits().IsRooted = false
+
+	for _, terminal := range terminals {
+		if _, ok := adjacencyMap[terminal]; !ok {
+			return nil, fmt.Errorf("could not find terminal vertex with hash %v", terminal)
+		}
+
+		if err := addVertexFrom(closure, g, terminal); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, source := range terminals {
+		distances := shortestDistances(adjacencyMap, source, g.Traits().IsWeighted)
+
+		for _, target := range terminals {
+			if source == target || closure.HasEdge(source, target) {
+				continue
+			}
+
+			distance, ok := distances[target]
+			if !ok {
+				return nil, fmt.Errorf("terminal %v cannot reach terminal %v: %w", source, target, ErrTargetNotReachable)
+			}
+
+			if err := closure.AddEdge(source, target, EdgeWeight(int(distance))); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+			}
+		}
+	}
+
+	return closure, nil
 }
 
 type sccState[K comparable] struct {
@@ -149,7 +684,8 @@ type sccState[K comparable] struct {
 // StronglyConnectedComponents detects all strongly connected components within the given graph
 // and returns the hashes of the vertices shaping these components, so each component is a []K.
 //
-// The current implementation uses Tarjan's algorithm and runs recursively.
+// The current implementation uses Tarjan's algorithm and runs recursively. The vertices are visited
+// in ascending order of their hashes, so the order of the returned components is deterministic.
 func StronglyConnectedComponents[K comparable, T any](g Graph[K, T]) ([][]K, error) {
 	if !g.Traits().IsDirected {
 		return nil, errors.New("SCCs can only be detected in directed graphs")
@@ -170,7 +706,7 @@ func StronglyConnectedComponents[K comparable, T any](g Graph[K, T]) ([][]K, err
 		index:        make(map[K]int),
 	}
 
-	for hash := range state.adjacencyMap {
+	for _, hash := range sortedMapKeys(state.adjacencyMap) {
 		if _, ok := state.visited[hash]; !ok {
 			findSCC(hash, state)
 		}
@@ -179,6 +715,27 @@ func StronglyConnectedComponents[K comparable, T any](g Graph[K, T]) ([][]K, err
 	return state.components, nil
 }
 
+// SCCMembership detects all strongly connected components within the given graph like
+// StronglyConnectedComponents does, but returns a map from each vertex to the index of its
+// component instead. The indices match the order of the components returned by
+// StronglyConnectedComponents for the same graph.
+func SCCMembership[K comparable, T any](g Graph[K, T]) (map[K]int, error) {
+	components, err := StronglyConnectedComponents(g)
+	if err != nil {
+		return nil, err
+	}
+
+	membership := make(map[K]int)
+
+	for i, component := range components {
+		for _, vertex := range component {
+			membership[vertex] = i
+		}
+	}
+
+	return membership, nil
+}
+
 func findSCC[K comparable](vertexHash K, state *sccState[K]) {
 	state.stack = append(state.stack, vertexHash)
 	state.onStack[vertexHash] = true
@@ -188,7 +745,7 @@ func findSCC[K comparable](vertexHash K, state *sccState[K]) {
 
 	state.time++
 
-	for adjacency := range state.adjacencyMap[vertexHash] {
+	for _, adjacency := range sortedMapKeys(state.adjacencyMap[vertexHash]) {
 		if _, ok := state.visited[adjacency]; !ok {
 			findSCC(adjacency, state)
 
@@ -214,15 +771,18 @@ func findSCC[K comparable](vertexHash K, state *sccState[K]) {
 	// If the lowlink value of the vertex is equal to its DFS index, this is th head vertex of a
 	// strongly connected component, shaped by this vertex and the vertices on the stack.
 	if state.lowlink[vertexHash] == state.index[vertexHash] {
-		var hash K
 		var component []K
 
-		for hash != vertexHash {
-			hash = state.stack[len(state.stack)-1]
+		for {
+			hash := state.stack[len(state.stack)-1]
 			state.stack = state.stack[:len(state.stack)-1]
 			state.onStack[hash] = false
 
 			component = append(component, hash)
+
+			if hash == vertexHash {
+				break
+			}
 		}
 
 		state.components = append(state.components, component)
@@ -1,6 +1,11 @@
 package graph
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
 
 func TestDirectedCreatesCycle(t *testing.T) {
 	tests := map[string]struct {
@@ -161,6 +166,7 @@ func TestDirectedShortestPath(t *testing.T) {
 		sourceHash           string
 		targetHash           string
 		expectedShortestPath []string
+		expectedErr          error
 		shouldFail           bool
 	}{
 		"graph as on img/dijkstra.svg": {
@@ -214,6 +220,7 @@ func TestDirectedShortestPath(t *testing.T) {
 			sourceHash:           "A",
 			targetHash:           "D",
 			expectedShortestPath: []string{},
+			expectedErr:          ErrTargetNotReachable,
 			shouldFail:           true,
 		},
 	}
@@ -237,6 +244,10 @@ func TestDirectedShortestPath(t *testing.T) {
 			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, (err != nil), err)
 		}
 
+		if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+			t.Errorf("%s: error expectancy doesn't match: expected %v, got %v", name, test.expectedErr, err)
+		}
+
 		if len(shortestPath) != len(test.expectedShortestPath) {
 			t.Fatalf("%s: path length expectancy doesn't match: expected %v, got %v", name, len(test.expectedShortestPath), len(shortestPath))
 		}
@@ -344,6 +355,486 @@ func TestUndirectedShortestPath(t *testing.T) {
 	}
 }
 
+func TestShortestPathDistance(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		vertices         []string
+		edges            []Edge[string]
+		source           string
+		target           string
+		expectedDistance int
+		expectedErr      error
+		shouldFail       bool
+	}{
+		"graph as on img/dijkstra.svg": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C", "D", "E", "F", "G"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 4}},
+				{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "E", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "E", Target: "F", Properties: EdgeProperties{Weight: 3}},
+				{Source: "F", Target: "G", Properties: EdgeProperties{Weight: 5}},
+				{Source: "G", Target: "B", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:           "A",
+			target:           "B",
+			expectedDistance: 6,
+		},
+		"undirected graph": {
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 4}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:           "B",
+			target:           "A",
+			expectedDistance: 3,
+		},
+		"source equal to target": {
+			isDirected:       true,
+			vertices:         []string{"A", "B"},
+			edges:            []Edge[string]{{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}}},
+			source:           "A",
+			target:           "A",
+			expectedDistance: 0,
+		},
+		"target not reachable": {
+			isDirected:  true,
+			vertices:    []string{"A", "B"},
+			edges:       []Edge[string]{{Source: "B", Target: "A", Properties: EdgeProperties{Weight: 1}}},
+			source:      "A",
+			target:      "B",
+			expectedErr: ErrTargetNotReachable,
+			shouldFail:  true,
+		},
+	}
+
+	for name, test := range tests {
+		options := []func(*Traits){Weighted()}
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(StringHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		distance, err := ShortestPathDistance(graph, test.source, test.target)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+			t.Errorf("%s: error expectancy doesn't match: expected %v, got %v", name, test.expectedErr, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if distance != test.expectedDistance {
+			t.Errorf("%s: distance expectancy doesn't match: expected %v, got %v", name, test.expectedDistance, distance)
+		}
+	}
+}
+
+func TestShortestPathFunc(t *testing.T) {
+	less := func(a, b string) bool { return a < b }
+	greater := func(a, b string) bool { return a > b }
+
+	tests := map[string]struct {
+		isDirected   bool
+		isWeighted   bool
+		vertices     []string
+		edges        []Edge[string]
+		source       string
+		target       string
+		tieBreak     func(a, b string) bool
+		expectedPath []string
+		shouldFail   bool
+	}{
+		"diamond preferring smallest next hop": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:       "A",
+			target:       "D",
+			tieBreak:     less,
+			expectedPath: []string{"A", "B", "D"},
+		},
+		"diamond preferring greatest next hop": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:       "A",
+			target:       "D",
+			tieBreak:     greater,
+			expectedPath: []string{"A", "C", "D"},
+		},
+		"shorter path isn't affected by tie-breaking": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:       "A",
+			target:       "D",
+			tieBreak:     less,
+			expectedPath: []string{"A", "C", "D"},
+		},
+		"undirected grid": {
+			vertices: []string{"A", "B", "C", "D", "E", "F"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+				{Source: "D", Target: "E"},
+				{Source: "E", Target: "F"},
+				{Source: "A", Target: "D"},
+				{Source: "B", Target: "E"},
+				{Source: "C", Target: "F"},
+			},
+			source:       "F",
+			target:       "A",
+			tieBreak:     less,
+			expectedPath: []string{"F", "C", "B", "A"},
+		},
+		"source equal to target": {
+			isDirected:   true,
+			vertices:     []string{"A", "B"},
+			edges:        []Edge[string]{{Source: "A", Target: "B"}},
+			source:       "A",
+			target:       "A",
+			tieBreak:     less,
+			expectedPath: []string{"A"},
+		},
+		"target not reachable": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			edges:      []Edge[string]{{Source: "A", Target: "B"}},
+			source:     "B",
+			target:     "A",
+			tieBreak:   less,
+			shouldFail: true,
+		},
+		"unknown target": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			source:     "A",
+			target:     "X",
+			tieBreak:   less,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var traits []func(*Traits)
+		if test.isDirected {
+			traits = append(traits, Directed())
+		}
+		if test.isWeighted {
+			traits = append(traits, Weighted())
+		}
+
+		graph := New(StringHash, traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		// Repeat the search to make sure that the result doesn't depend on the map order.
+		for i := 0; i < 10; i++ {
+			path, err := ShortestPathFunc(graph, test.source, test.target, test.tieBreak)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+			}
+
+			if test.shouldFail {
+				break
+			}
+
+			if !orderedSlicesAreEqual(path, test.expectedPath) {
+				t.Fatalf("%s: path expectancy doesn't match: expected %v, got %v", name, test.expectedPath, path)
+			}
+		}
+	}
+}
+
+func TestShortestPathsFrom(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		isWeighted    bool
+		vertices      []string
+		edges         []Edge[string]
+		source        string
+		targets       []string
+		expectedPaths map[string][]string
+		shouldFail    bool
+	}{
+		"weighted graph as on img/dijkstra.svg": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []string{"A", "B", "C", "D", "E", "F", "G"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 4}},
+				{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "E", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "E", Target: "F", Properties: EdgeProperties{Weight: 3}},
+				{Source: "F", Target: "G", Properties: EdgeProperties{Weight: 5}},
+				{Source: "G", Target: "B", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:  "A",
+			targets: []string{"B", "D", "G", "A"},
+			expectedPaths: map[string][]string{
+				"B": {"A", "C", "E", "B"},
+				"D": {"A", "C", "D"},
+				"G": {"A", "F", "G"},
+				"A": {"A"},
+			},
+		},
+		"unweighted graph counts hops": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 10}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 10}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "B", Properties: EdgeProperties{Weight: 1}},
+			},
+			source:  "A",
+			targets: []string{"D"},
+			expectedPaths: map[string][]string{
+				"D": {"A", "B", "D"},
+			},
+		},
+		"unreachable targets are omitted": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "C", Target: "A"},
+			},
+			source:  "A",
+			targets: []string{"B", "C"},
+			expectedPaths: map[string][]string{
+				"B": {"A", "B"},
+			},
+		},
+		"unknown target": {
+			vertices:   []string{"A", "B"},
+			source:     "A",
+			targets:    []string{"B", "X"},
+			shouldFail: true,
+		},
+		"unknown source": {
+			vertices:   []string{"A", "B"},
+			source:     "X",
+			targets:    []string{"A"},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var traits []func(*Traits)
+		if test.isDirected {
+			traits = append(traits, Directed())
+		}
+		if test.isWeighted {
+			traits = append(traits, Weighted())
+		}
+
+		graph := New(StringHash, traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		paths, err := ShortestPathsFrom(graph, test.source, test.targets)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(paths) != len(test.expectedPaths) {
+			t.Fatalf("%s: number of paths doesn't match: expected %v, got %v", name, len(test.expectedPaths), len(paths))
+		}
+
+		for target, expectedPath := range test.expectedPaths {
+			if !orderedSlicesAreEqual(paths[target], expectedPath) {
+				t.Errorf("%s: path expectancy for %v doesn't match: expected %v, got %v", name, target, expectedPath, paths[target])
+			}
+		}
+	}
+}
+
+func TestWidestPath(t *testing.T) {
+	tests := map[string]struct {
+		isDirected         bool
+		isWeighted         bool
+		vertices           []string
+		edges              []Edge[string]
+		source             string
+		target             string
+		expectedPath       []string
+		expectedBottleneck int
+		shouldFail         bool
+	}{
+		"wide detour": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 10}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 8}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 9}},
+			},
+			source:             "A",
+			target:             "D",
+			expectedPath:       []string{"A", "B", "C", "D"},
+			expectedBottleneck: 8,
+		},
+		"undirected graph with narrow bridge": {
+			isWeighted: true,
+			vertices:   []string{"A", "B", "C", "D", "E"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 5}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 4}},
+				{Source: "D", Target: "E", Properties: EdgeProperties{Weight: 7}},
+			},
+			source:             "E",
+			target:             "A",
+			expectedPath:       []string{"E", "D", "C", "A"},
+			expectedBottleneck: 3,
+		},
+		"unweighted graph": {
+			isDirected:         true,
+			vertices:           []string{"A", "B"},
+			edges:              []Edge[string]{{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 5}}},
+			source:             "A",
+			target:             "B",
+			expectedPath:       []string{"A", "B"},
+			expectedBottleneck: 1,
+		},
+		"source equal to target": {
+			isDirected:         true,
+			isWeighted:         true,
+			vertices:           []string{"A"},
+			source:             "A",
+			target:             "A",
+			expectedPath:       []string{"A"},
+			expectedBottleneck: math.MaxInt,
+		},
+		"target not reachable": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []string{"A", "B"},
+			edges:      []Edge[string]{{Source: "B", Target: "A", Properties: EdgeProperties{Weight: 1}}},
+			source:     "A",
+			target:     "B",
+			shouldFail: true,
+		},
+		"unknown source": {
+			vertices:   []string{"A"},
+			source:     "X",
+			target:     "A",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var traits []func(*Traits)
+		if test.isDirected {
+			traits = append(traits, Directed())
+		}
+		if test.isWeighted {
+			traits = append(traits, Weighted())
+		}
+
+		graph := New(StringHash, traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		path, bottleneck, err := WidestPath(graph, test.source, test.target)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !orderedSlicesAreEqual(path, test.expectedPath) {
+			t.Errorf("%s: path expectancy doesn't match: expected %v, got %v", name, test.expectedPath, path)
+		}
+
+		if bottleneck != test.expectedBottleneck {
+			t.Errorf("%s: bottleneck expectancy doesn't match: expected %v, got %v", name, test.expectedBottleneck, bottleneck)
+		}
+	}
+}
+
 func TestDirectedStronglyConnectedComponents(t *testing.T) {
 	tests := map[string]struct {
 		vertices     []int
@@ -370,6 +861,15 @@ func TestDirectedStronglyConnectedComponents(t *testing.T) {
 			},
 			expectedSCCs: [][]int{{1, 2, 5}, {3, 4, 8}, {6, 7}},
 		},
+		"graph with zero-valued vertex": {
+			vertices: []int{0, 1, 2},
+			edges: []Edge[int]{
+				{Source: 0, Target: 1},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			expectedSCCs: [][]int{{0}, {1, 2}},
+		},
 	}
 
 	for name, test := range tests {
@@ -402,28 +902,741 @@ func TestDirectedStronglyConnectedComponents(t *testing.T) {
 	}
 }
 
-func TestUndirectedStronglyConnectedComponents(t *testing.T) {
+func TestSCCMembership(t *testing.T) {
 	tests := map[string]struct {
-		expectedSCCs [][]int
-		shouldFail   bool
+		isDirected bool
+		vertices   []int
+		edges      []Edge[int]
+		sameSCC    [][2]int
+		otherSCC   [][2]int
+		shouldFail bool
 	}{
-		"return error": {
-			expectedSCCs: nil,
-			shouldFail:   true,
+		"graph with SCCs as on img/scc.svg": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4, 5, 6, 7, 8},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 5},
+				{Source: 2, Target: 6},
+				{Source: 3, Target: 4},
+				{Source: 3, Target: 7},
+				{Source: 4, Target: 3},
+				{Source: 4, Target: 8},
+				{Source: 5, Target: 1},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 7},
+				{Source: 7, Target: 6},
+				{Source: 8, Target: 4},
+				{Source: 8, Target: 7},
+			},
+			sameSCC:  [][2]int{{1, 2}, {2, 5}, {3, 4}, {4, 8}, {6, 7}},
+			otherSCC: [][2]int{{1, 3}, {3, 6}, {5, 7}},
+		},
+		"graph with isolated vertex": {
+			isDirected: true,
+			vertices:   []int{0, 1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			sameSCC:  [][2]int{{1, 2}},
+			otherSCC: [][2]int{{0, 1}},
+		},
+		"undirected graph": {
+			vertices:   []int{1, 2},
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			shouldFail: true,
 		},
 	}
 
 	for name, test := range tests {
-		graph := New(IntHash)
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
 
-		sccs, err := StronglyConnectedComponents(graph)
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
 
-		if test.shouldFail != (err != nil) {
-			t.Errorf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, (err != nil), err)
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
 		}
 
-		if test.expectedSCCs == nil && sccs != nil {
+		membership, err := SCCMembership(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(membership) != len(test.vertices) {
+			t.Errorf("%s: membership size expectancy doesn't match: expected %v, got %v", name, len(test.vertices), len(membership))
+		}
+
+		for _, pair := range test.sameSCC {
+			if membership[pair[0]] != membership[pair[1]] {
+				t.Errorf("%s: expected %v and %v to be in the same SCC, got %v", name, pair[0], pair[1], membership)
+			}
+		}
+
+		for _, pair := range test.otherSCC {
+			if membership[pair[0]] == membership[pair[1]] {
+				t.Errorf("%s: expected %v and %v to be in different SCCs, got %v", name, pair[0], pair[1], membership)
+			}
+		}
+
+		sccs, _ := StronglyConnectedComponents(graph)
+
+		for i, scc := range sccs {
+			for _, vertex := range scc {
+				if membership[vertex] != i {
+					t.Errorf("%s: index of vertex %v doesn't match: expected %v, got %v", name, vertex, i, membership[vertex])
+				}
+			}
+		}
+	}
+}
+
+func TestUndirectedStronglyConnectedComponents(t *testing.T) {
+	tests := map[string]struct {
+		expectedSCCs [][]int
+		shouldFail   bool
+	}{
+		"return error": {
+			expectedSCCs: nil,
+			shouldFail:   true,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		sccs, err := StronglyConnectedComponents(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Errorf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, (err != nil), err)
+		}
+
+		if test.expectedSCCs == nil && sccs != nil {
 			t.Errorf("%s: SCC expectancy doesn't match: expcted %v, got %v", name, test.expectedSCCs, sccs)
 		}
 	}
 }
+
+func TestIterativeDeepeningDFS(t *testing.T) {
+	tests := map[string]struct {
+		isDirected   bool
+		vertices     []int
+		edges        []Edge[int]
+		source       int
+		target       int
+		maxDepth     int
+		expectedPath []int
+		expectedErr  error
+	}{
+		"shallowest path in directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 5},
+				{Source: 1, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			source:       1,
+			target:       5,
+			maxDepth:     5,
+			expectedPath: []int{1, 4, 5},
+		},
+		"undirected cycle": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			source:       1,
+			target:       3,
+			maxDepth:     3,
+			expectedPath: nil,
+		},
+		"source equals target": {
+			vertices:     []int{1},
+			source:       1,
+			target:       1,
+			maxDepth:     0,
+			expectedPath: []int{1},
+		},
+		"target beyond maximum depth": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			source:      1,
+			target:      3,
+			maxDepth:    1,
+			expectedErr: ErrTargetNotReachable,
+		},
+		"unreachable target": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 2, Target: 1},
+			},
+			source:      1,
+			target:      2,
+			maxDepth:    10,
+			expectedErr: ErrTargetNotReachable,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		path, err := IterativeDeepeningDFS(graph, test.source, test.target, test.maxDepth)
+
+		if !errors.Is(err, test.expectedErr) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v", name, test.expectedErr, err)
+		}
+
+		if test.expectedErr != nil {
+			continue
+		}
+
+		if test.expectedPath == nil {
+			// There are multiple shallowest paths, so only their length and validity is checked.
+			if len(path) != 3 || path[0] != test.source || path[2] != test.target {
+				t.Errorf("%s: path expectancy doesn't match: got %v", name, path)
+			}
+			continue
+		}
+
+		if len(path) != len(test.expectedPath) {
+			t.Fatalf("%s: path length expectancy doesn't match: expected %v, got %v", name, test.expectedPath, path)
+		}
+
+		for i, expectedVertex := range test.expectedPath {
+			if path[i] != expectedVertex {
+				t.Errorf("%s: path expectancy doesn't match: expected %v, got %v", name, test.expectedPath, path)
+				break
+			}
+		}
+	}
+}
+
+func TestShortestPathTree(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		isWeighted    bool
+		vertices      []int
+		edges         []Edge[int]
+		source        int
+		less          func(a, b int) bool
+		expectedEdges []Edge[int]
+		shouldFail    bool
+	}{
+		"weighted directed graph": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 4}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 1}},
+				{Source: 5, Target: 1, Properties: EdgeProperties{Weight: 1}},
+			},
+			source: 1,
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+		},
+		"unweighted undirected square with tie-breaking": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			source: 1,
+			less: func(a, b int) bool {
+				return a > b
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 4},
+			},
+		},
+		"weighted undirected graph with tie-breaking": {
+			isWeighted: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 4, Properties: EdgeProperties{Weight: 2}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 2}},
+			},
+			source: 1,
+			less: func(a, b int) bool {
+				return a < b
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+			},
+		},
+		"unknown source vertex": {
+			vertices:   []int{1},
+			source:     2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+		if test.isWeighted {
+			options = append(options, Weighted())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		tree, err := ShortestPathTreeFunc(graph, test.source, test.less)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if tree.Size() != len(test.expectedEdges) {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), tree.Size())
+		}
+
+		if tree.Order() != len(test.expectedEdges)+1 {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges)+1, tree.Order())
+		}
+
+		for _, expectedEdge := range test.expectedEdges {
+			if _, err := tree.Edge(expectedEdge.Source, expectedEdge.Target); err != nil {
+				t.Errorf("%s: expected edge (%v, %v) doesn't exist", name, expectedEdge.Source, expectedEdge.Target)
+			}
+		}
+	}
+}
+
+func TestUnweightedDistances(t *testing.T) {
+	tests := map[string]struct {
+		isDirected        bool
+		vertices          []int
+		edges             []Edge[int]
+		source            int
+		expectedDistances map[int]int
+		shouldFail        bool
+	}{
+		"directed graph with unreachable vertex": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 5, Target: 1},
+			},
+			source: 1,
+			expectedDistances: map[int]int{
+				1: 0,
+				2: 1,
+				3: 1,
+				4: 2,
+			},
+		},
+		"undirected graph ignoring weights": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 10}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 10}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 50}},
+			},
+			source: 3,
+			expectedDistances: map[int]int{
+				1: 1,
+				2: 1,
+				3: 0,
+			},
+		},
+		"isolated source": {
+			vertices: []int{1, 2},
+			source:   2,
+			expectedDistances: map[int]int{
+				2: 0,
+			},
+		},
+		"missing source": {
+			vertices:   []int{1},
+			source:     2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed(), Weighted())
+		} else {
+			graph = New(IntHash, Weighted())
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		distances, err := UnweightedDistances(graph, test.source)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(distances) != len(test.expectedDistances) {
+			t.Errorf("%s: distance count expectancy doesn't match: expected %v, got %v", name, len(test.expectedDistances), len(distances))
+		}
+
+		for vertex, expectedDistance := range test.expectedDistances {
+			if distance, ok := distances[vertex]; !ok || distance != expectedDistance {
+				t.Errorf("%s: distance expectancy for %v doesn't match: expected %v, got %v", name, vertex, expectedDistance, distance)
+			}
+		}
+	}
+}
+
+func TestMetricClosure(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		isWeighted    bool
+		isAcyclic     bool
+		vertices      []int
+		edges         []Edge[int]
+		terminals     []int
+		expectedEdges []Edge[int]
+		expectedErr   error
+		shouldFail    bool
+	}{
+		"weighted undirected graph": {
+			isWeighted: true,
+			vertices:   []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 5}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 1}},
+				{Source: 4, Target: 5, Properties: EdgeProperties{Weight: 1}},
+			},
+			terminals: []int{1, 3, 5},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 4}},
+				{Source: 1, Target: 5, Properties: EdgeProperties{Weight: 6}},
+				{Source: 3, Target: 5, Properties: EdgeProperties{Weight: 2}},
+			},
+		},
+		"unweighted tree": {
+			isAcyclic: true,
+			vertices:  []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			terminals: []int{2, 3, 4},
+			expectedEdges: []Edge[int]{
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 4, Properties: EdgeProperties{Weight: 2}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 2}},
+			},
+		},
+		"strongly connected directed graph": {
+			isDirected: true,
+			isWeighted: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Weight: 1}},
+			},
+			terminals: []int{1, 3},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Weight: 1}},
+			},
+		},
+		"unreachable terminal": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			terminals:   []int{1, 2},
+			expectedErr: ErrTargetNotReachable,
+			shouldFail:  true,
+		},
+		"missing terminal": {
+			vertices:   []int{1, 2},
+			terminals:  []int{1, 3},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		traits := []func(*Traits){}
+		if test.isDirected {
+			traits = append(traits, Directed())
+		}
+		if test.isWeighted {
+			traits = append(traits, Weighted())
+		}
+		if test.isAcyclic {
+			traits = append(traits, Acyclic())
+		}
+
+		graph := New(IntHash, traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		closure, err := MetricClosure(graph, test.terminals)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+			t.Errorf("%s: error expectancy doesn't match: expected %v, got %v", name, test.expectedErr, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !closure.Traits().IsWeighted {
+			t.Errorf("%s: closure is expected to be weighted", name)
+		}
+
+		if closure.Order() != len(test.terminals) {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, len(test.terminals), closure.Order())
+		}
+
+		if closure.Size() != len(test.expectedEdges) {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), closure.Size())
+		}
+
+		for _, expectedEdge := range test.expectedEdges {
+			edge, err := closure.Edge(expectedEdge.Source, expectedEdge.Target)
+			if err != nil {
+				t.Fatalf("%s: edge (%v, %v) expected", name, expectedEdge.Source, expectedEdge.Target)
+			}
+
+			if edge.Properties.Weight != expectedEdge.Properties.Weight {
+				t.Errorf("%s: weight expectancy of edge (%v, %v) doesn't match: expected %v, got %v", name, expectedEdge.Source, expectedEdge.Target, expectedEdge.Properties.Weight, edge.Properties.Weight)
+			}
+		}
+	}
+}
+
+func TestCycleError(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []int
+		edges         []Edge[int]
+		source        int
+		target        int
+		expectedCycle []int
+	}{
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			source:        4,
+			target:        2,
+			expectedCycle: []int{4, 2, 3},
+		},
+		"undirected graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+			},
+			source:        2,
+			target:        3,
+			expectedCycle: []int{2, 3, 1},
+		},
+		"self-loop": {
+			isDirected:    true,
+			vertices:      []int{1},
+			source:        1,
+			target:        1,
+			expectedCycle: []int{1},
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed(), Acyclic())
+		} else {
+			graph = New(IntHash, Acyclic())
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		err := graph.AddEdge(test.source, test.target)
+
+		if !errors.Is(err, ErrEdgeCreatesCycle) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v", name, ErrEdgeCreatesCycle, err)
+		}
+
+		var cycleErr *CycleError[int]
+		if !errors.As(err, &cycleErr) {
+			t.Fatalf("%s: error type expectancy doesn't match: expected *CycleError, got %T", name, err)
+		}
+
+		if !orderedSlicesAreEqual(cycleErr.Cycle(), test.expectedCycle) {
+			t.Errorf("%s: cycle expectancy doesn't match: expected %v, got %v", name, test.expectedCycle, cycleErr.Cycle())
+		}
+	}
+}
+
+// TestShortestPathTree_Random compares the distances in shortest path trees of random graphs with
+// the distances computed by the Floyd-Warshall algorithm. It used to fail because the priority
+// queue didn't always pop the item with the smallest priority.
+func TestShortestPathTree_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for round := 0; round < 50; round++ {
+		n := 15
+		graph := New(IntHash, Directed(), Weighted())
+
+		for i := 0; i < n; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		for i := 0; i < 4*n; i++ {
+			_ = graph.AddEdge(rng.Intn(n), rng.Intn(n), EdgeWeight(rng.Intn(20)))
+		}
+
+		expected := floydWarshallDistances(t, graph)
+
+		tree, err := ShortestPathTree(graph, 0)
+		if err != nil {
+			t.Fatalf("failed to compute shortest path tree: %s", err.Error())
+		}
+
+		predecessorMap, _ := tree.PredecessorMap()
+
+		for vertex, expectedDistance := range expected[0] {
+			distance := 0
+			for current := vertex; current != 0; {
+				for predecessor, edge := range predecessorMap[current] {
+					distance += edge.Properties.Weight
+					current = predecessor
+				}
+			}
+
+			if distance != expectedDistance {
+				t.Fatalf("round %d: distance of vertex %v doesn't match: expected %v, got %v", round, vertex, expectedDistance, distance)
+			}
+		}
+
+		path, err := ShortestPath(graph, 0, n-1)
+		if _, reachable := expected[0][n-1]; reachable != (err == nil) {
+			t.Fatalf("round %d: reachability expectancy doesn't match: expected %v, got %v", round, reachable, err == nil)
+		}
+
+		if err == nil {
+			weight := 0
+			for i := 1; i < len(path); i++ {
+				edge, _ := graph.Edge(path[i-1], path[i])
+				weight += edge.Properties.Weight
+			}
+
+			if weight != expected[0][n-1] {
+				t.Fatalf("round %d: path weight doesn't match: expected %v, got %v", round, expected[0][n-1], weight)
+			}
+		}
+	}
+}
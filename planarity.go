@@ -0,0 +1,468 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// IsPlanar determines whether an undirected graph is planar, i.e. whether it can be drawn in the
+// plane without any crossing edges. Self-loops don't affect planarity and are ignored.
+//
+// IsPlanar implements the left-right planarity test by de Fraysseix and Rosenstiehl as described
+// by Brandes, which runs in linear time. Graphs with more than 3n-6 edges are rejected right away
+// since they can't be planar according to Euler's formula.
+func IsPlanar[K comparable, T any](g Graph[K, T]) (bool, error) {
+	if g.Traits().IsDirected {
+		return false, errors.New("planarity can only be tested for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return false, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	_, adjacencies := indexedAdjacencies(adjacencyMap, nil)
+
+	return isPlanar(adjacencies), nil
+}
+
+// KuratowskiSubgraph returns the edges of a subgraph that proves that an undirected graph is not
+// planar. By Kuratowski's theorem, such a subgraph is a subdivision of either K5, the complete
+// graph on 5 vertices, or K3,3, the complete bipartite graph on 3+3 vertices. If the graph is
+// planar, KuratowskiSubgraph returns nil.
+//
+// The subgraph is found by removing each edge that isn't required for the graph to stay
+// non-planar, which requires one planarity test per edge and therefore takes O(m*(n+m)) time.
+func KuratowskiSubgraph[K comparable, T any](g Graph[K, T]) ([]Edge[K], error) {
+	if g.Traits().IsDirected {
+		return nil, errors.New("planarity can only be tested for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	edges := make([]Edge[K], 0)
+	for _, edge := range sortedEdges(adjacencyMap, false) {
+		if edge.Source != edge.Target {
+			edges = append(edges, edge)
+		}
+	}
+
+	_, adjacencies := indexedAdjacencies(adjacencyMap, edges)
+	if isPlanar(adjacencies) {
+		return nil, nil
+	}
+
+	required := make([]bool, len(edges))
+
+	for i := range edges {
+		required[i] = true
+
+		remaining := make([]Edge[K], 0, len(edges))
+		for j, edge := range edges {
+			if j < i && required[j] || j > i {
+				remaining = append(remaining, edge)
+			}
+		}
+
+		// If the graph stays non-planar without the edge, the edge isn't needed for the witness.
+		_, adjacencies := indexedAdjacencies(adjacencyMap, remaining)
+		if !isPlanar(adjacencies) {
+			required[i] = false
+		}
+	}
+
+	witness := make([]Edge[K], 0)
+	for i, edge := range edges {
+		if required[i] {
+			witness = append(witness, edge)
+		}
+	}
+
+	return witness, nil
+}
+
+// indexedAdjacencies maps the vertices of an undirected graph to consecutive indices and returns
+// the vertices along with the adjacency lists of those indices in a deterministic order. Self-loops
+// are omitted. If edges is not nil, only the given edges are taken into account.
+func indexedAdjacencies[K comparable](adjacencyMap map[K]map[K]Edge[K], edges []Edge[K]) ([]K, [][]int) {
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sortKeys(vertices)
+
+	indices := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		indices[vertex] = i
+	}
+
+	if edges == nil {
+		edges = sortedEdges(adjacencyMap, false)
+	}
+
+	adjacencies := make([][]int, len(vertices))
+
+	for _, edge := range edges {
+		source, target := indices[edge.Source], indices[edge.Target]
+		if source == target {
+			continue
+		}
+
+		adjacencies[source] = append(adjacencies[source], target)
+		adjacencies[target] = append(adjacencies[target], source)
+	}
+
+	return vertices, adjacencies
+}
+
+// lrEdge is an oriented edge between two vertex indices used by the left-right planarity test.
+type lrEdge struct {
+	source int
+	target int
+}
+
+// noEdge represents the absence of an edge in intervals and references.
+var noEdge = lrEdge{source: -1, target: -1}
+
+// lrInterval is an interval of return edges, delimited by its lowest and highest return edge.
+type lrInterval struct {
+	low  lrEdge
+	high lrEdge
+}
+
+func (i lrInterval) isEmpty() bool {
+	return i.low == noEdge && i.high == noEdge
+}
+
+// lrConflictPair is a pair of intervals of return edges that have to be on different sides.
+type lrConflictPair struct {
+	left  lrInterval
+	right lrInterval
+}
+
+func (p *lrConflictPair) swap() {
+	p.left, p.right = p.right, p.left
+}
+
+// lrPlanarity holds the state of a left-right planarity test.
+type lrPlanarity struct {
+	adjacencies  [][]int
+	height       []int
+	parentEdge   []lrEdge
+	oriented     map[lrEdge]bool
+	children     [][]int
+	lowpt        map[lrEdge]int
+	lowpt2       map[lrEdge]int
+	nestingDepth map[lrEdge]int
+	ref          map[lrEdge]lrEdge
+	lowptEdge    map[lrEdge]lrEdge
+	stackBottom  map[lrEdge]*lrConflictPair
+	stack        []*lrConflictPair
+}
+
+// isPlanar runs the left-right planarity test on a simple undirected graph whose vertices are
+// represented by their indices in the adjacency lists.
+func isPlanar(adjacencies [][]int) bool {
+	n := len(adjacencies)
+
+	m := 0
+	for _, adjacency := range adjacencies {
+		m += len(adjacency)
+	}
+	m /= 2
+
+	if n > 2 && m > 3*n-6 {
+		return false
+	}
+
+	state := &lrPlanarity{
+		adjacencies:  adjacencies,
+		height:       make([]int, n),
+		parentEdge:   make([]lrEdge, n),
+		oriented:     make(map[lrEdge]bool),
+		children:     make([][]int, n),
+		lowpt:        make(map[lrEdge]int),
+		lowpt2:       make(map[lrEdge]int),
+		nestingDepth: make(map[lrEdge]int),
+		ref:          make(map[lrEdge]lrEdge),
+		lowptEdge:    make(map[lrEdge]lrEdge),
+		stackBottom:  make(map[lrEdge]*lrConflictPair),
+	}
+
+	roots := make([]int, 0)
+
+	for v := 0; v < n; v++ {
+		state.height[v] = -1
+		state.parentEdge[v] = noEdge
+	}
+
+	for v := 0; v < n; v++ {
+		if state.height[v] == -1 {
+			state.height[v] = 0
+			roots = append(roots, v)
+			state.orient(v)
+		}
+	}
+
+	// The outgoing edges of each vertex are visited in the order of their nesting depth.
+	for v := 0; v < n; v++ {
+		children := state.children[v]
+		sort.SliceStable(children, func(i, j int) bool {
+			return state.nestingDepth[lrEdge{v, children[i]}] < state.nestingDepth[lrEdge{v, children[j]}]
+		})
+	}
+
+	for _, root := range roots {
+		if !state.test(root) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// orient performs the first depth-first search of the test, which orients the edges and computes
+// their lowpoints and nesting depths.
+func (s *lrPlanarity) orient(v int) {
+	e := s.parentEdge[v]
+
+	for _, w := range s.adjacencies[v] {
+		if s.oriented[lrEdge{v, w}] || s.oriented[lrEdge{w, v}] {
+			continue
+		}
+
+		vw := lrEdge{v, w}
+		s.oriented[vw] = true
+		s.children[v] = append(s.children[v], w)
+
+		s.lowpt[vw] = s.height[v]
+		s.lowpt2[vw] = s.height[v]
+
+		if s.height[w] == -1 {
+			s.parentEdge[w] = vw
+			s.height[w] = s.height[v] + 1
+			s.orient(w)
+		} else {
+			s.lowpt[vw] = s.height[w]
+		}
+
+		s.nestingDepth[vw] = 2 * s.lowpt[vw]
+		if s.lowpt2[vw] < s.height[v] {
+			s.nestingDepth[vw]++
+		}
+
+		if e == noEdge {
+			continue
+		}
+
+		switch {
+		case s.lowpt[vw] < s.lowpt[e]:
+			s.lowpt2[e] = minInt(s.lowpt[e], s.lowpt2[vw])
+			s.lowpt[e] = s.lowpt[vw]
+		case s.lowpt[vw] > s.lowpt[e]:
+			s.lowpt2[e] = minInt(s.lowpt2[e], s.lowpt[vw])
+		default:
+			s.lowpt2[e] = minInt(s.lowpt2[e], s.lowpt2[vw])
+		}
+	}
+}
+
+// test performs the second depth-first search of the test, which checks whether the return edges
+// can be assigned to the left and right sides without conflicts.
+func (s *lrPlanarity) test(v int) bool {
+	e := s.parentEdge[v]
+
+	for i, w := range s.children[v] {
+		ei := lrEdge{v, w}
+		s.stackBottom[ei] = s.top()
+
+		if ei == s.parentEdge[w] {
+			if !s.test(w) {
+				return false
+			}
+		} else {
+			s.lowptEdge[ei] = ei
+			s.stack = append(s.stack, &lrConflictPair{
+				left:  lrInterval{low: noEdge, high: noEdge},
+				right: lrInterval{low: ei, high: ei},
+			})
+		}
+
+		if s.lowpt[ei] < s.height[v] {
+			if i == 0 {
+				s.lowptEdge[e] = s.lowptEdge[ei]
+			} else if !s.addConstraints(ei, e) {
+				return false
+			}
+		}
+	}
+
+	if e != noEdge {
+		s.removeBackEdges(e)
+	}
+
+	return true
+}
+
+func (s *lrPlanarity) addConstraints(ei, e lrEdge) bool {
+	p := &lrConflictPair{
+		left:  lrInterval{low: noEdge, high: noEdge},
+		right: lrInterval{low: noEdge, high: noEdge},
+	}
+
+	// Merge the return edges of ei into the right interval.
+	for {
+		q := s.pop()
+
+		if !q.left.isEmpty() {
+			q.swap()
+		}
+
+		if !q.left.isEmpty() {
+			return false
+		}
+
+		if s.lowpt[q.right.low] > s.lowpt[e] {
+			if p.right.isEmpty() {
+				p.right = q.right
+			} else {
+				s.ref[p.right.low] = q.right.high
+			}
+			p.right.low = q.right.low
+		} else {
+			s.ref[q.right.low] = s.lowptEdge[e]
+		}
+
+		if s.top() == s.stackBottom[ei] {
+			break
+		}
+	}
+
+	// Merge the conflicting return edges of the previous siblings of ei into the left interval.
+	for len(s.stack) > 0 && (s.isConflicting(s.top().left, ei) || s.isConflicting(s.top().right, ei)) {
+		q := s.pop()
+
+		if s.isConflicting(q.right, ei) {
+			q.swap()
+		}
+
+		if s.isConflicting(q.right, ei) {
+			return false
+		}
+
+		s.ref[p.right.low] = q.right.high
+		if q.right.low != noEdge {
+			p.right.low = q.right.low
+		}
+
+		if p.left.isEmpty() {
+			p.left = q.left
+		} else {
+			s.ref[p.left.low] = q.left.high
+		}
+		p.left.low = q.left.low
+	}
+
+	if !p.left.isEmpty() || !p.right.isEmpty() {
+		s.stack = append(s.stack, p)
+	}
+
+	return true
+}
+
+func (s *lrPlanarity) removeBackEdges(e lrEdge) {
+	u := e.source
+
+	// Drop all conflict pairs whose lowest return edge ends at the parent vertex.
+	for len(s.stack) > 0 && s.lowest(s.top()) == s.height[u] {
+		s.pop()
+	}
+
+	if len(s.stack) > 0 {
+		p := s.pop()
+
+		for p.left.high != noEdge && p.left.high.target == u {
+			p.left.high = s.reference(p.left.high)
+		}
+
+		if p.left.high == noEdge && p.left.low != noEdge {
+			s.ref[p.left.low] = p.right.low
+			p.left.low = noEdge
+		}
+
+		for p.right.high != noEdge && p.right.high.target == u {
+			p.right.high = s.reference(p.right.high)
+		}
+
+		if p.right.high == noEdge && p.right.low != noEdge {
+			s.ref[p.right.low] = p.left.low
+			p.right.low = noEdge
+		}
+
+		s.stack = append(s.stack, p)
+	}
+
+	if s.lowpt[e] < s.height[u] && len(s.stack) > 0 {
+		highLeft := s.top().left.high
+		highRight := s.top().right.high
+
+		if highLeft != noEdge && (highRight == noEdge || s.lowpt[highLeft] > s.lowpt[highRight]) {
+			s.ref[e] = highLeft
+		} else {
+			s.ref[e] = highRight
+		}
+	}
+}
+
+// reference returns the edge referenced by the given edge or noEdge if there is no such edge.
+func (s *lrPlanarity) reference(e lrEdge) lrEdge {
+	if ref, ok := s.ref[e]; ok {
+		return ref
+	}
+
+	return noEdge
+}
+
+func (s *lrPlanarity) isConflicting(i lrInterval, b lrEdge) bool {
+	return !i.isEmpty() && s.lowpt[i.high] > s.lowpt[b]
+}
+
+func (s *lrPlanarity) lowest(p *lrConflictPair) int {
+	if p.left.isEmpty() {
+		return s.lowpt[p.right.low]
+	}
+
+	if p.right.isEmpty() {
+		return s.lowpt[p.left.low]
+	}
+
+	return minInt(s.lowpt[p.left.low], s.lowpt[p.right.low])
+}
+
+func (s *lrPlanarity) top() *lrConflictPair {
+	if len(s.stack) == 0 {
+		return nil
+	}
+
+	return s.stack[len(s.stack)-1]
+}
+
+func (s *lrPlanarity) pop() *lrConflictPair {
+	p := s.stack[len(s.stack)-1]
+	s.stack = s.stack[:len(s.stack)-1]
+
+	return p
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,242 @@
+package graph
+
+import "testing"
+
+func TestIsPlanar(t *testing.T) {
+	tests := map[string]struct {
+		isDirected     bool
+		vertices       []int
+		edges          []Edge[int]
+		expectedPlanar bool
+		shouldFail     bool
+	}{
+		"empty graph": {
+			expectedPlanar: true,
+		},
+		"K4": {
+			vertices:       []int{1, 2, 3, 4},
+			edges:          completeEdges(1, 2, 3, 4),
+			expectedPlanar: true,
+		},
+		"K5": {
+			vertices:       []int{1, 2, 3, 4, 5},
+			edges:          completeEdges(1, 2, 3, 4, 5),
+			expectedPlanar: false,
+		},
+		"K5 without one edge": {
+			vertices:       []int{1, 2, 3, 4, 5},
+			edges:          completeEdges(1, 2, 3, 4, 5)[1:],
+			expectedPlanar: true,
+		},
+		"K3,3": {
+			vertices:       []int{1, 2, 3, 4, 5, 6},
+			edges:          completeBipartiteEdges([]int{1, 2, 3}, []int{4, 5, 6}),
+			expectedPlanar: false,
+		},
+		"subdivided K3,3": {
+			vertices: []int{1, 2, 3, 4, 5, 6, 7, 8},
+			edges: append(completeBipartiteEdges([]int{1, 2, 3}, []int{4, 5, 6})[2:],
+				Edge[int]{Source: 1, Target: 7},
+				Edge[int]{Source: 7, Target: 4},
+				Edge[int]{Source: 1, Target: 8},
+				Edge[int]{Source: 8, Target: 5},
+			),
+			expectedPlanar: false,
+		},
+		"Petersen graph": {
+			vertices: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			edges: []Edge[int]{
+				{Source: 0, Target: 1},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 0},
+				{Source: 0, Target: 5},
+				{Source: 1, Target: 6},
+				{Source: 2, Target: 7},
+				{Source: 3, Target: 8},
+				{Source: 4, Target: 9},
+				{Source: 5, Target: 7},
+				{Source: 7, Target: 9},
+				{Source: 9, Target: 6},
+				{Source: 6, Target: 8},
+				{Source: 8, Target: 5},
+			},
+			expectedPlanar: false,
+		},
+		"cube": {
+			vertices: []int{0, 1, 2, 3, 4, 5, 6, 7},
+			edges: []Edge[int]{
+				{Source: 0, Target: 1},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 2},
+				{Source: 2, Target: 0},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 7},
+				{Source: 7, Target: 6},
+				{Source: 6, Target: 4},
+				{Source: 0, Target: 4},
+				{Source: 1, Target: 5},
+				{Source: 2, Target: 6},
+				{Source: 3, Target: 7},
+			},
+			expectedPlanar: true,
+		},
+		"wheel with self-loop": {
+			vertices: []int{0, 1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 0, Target: 1},
+				{Source: 0, Target: 2},
+				{Source: 0, Target: 3},
+				{Source: 0, Target: 4},
+				{Source: 0, Target: 5},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 1},
+				{Source: 0, Target: 0},
+			},
+			expectedPlanar: true,
+		},
+		"disconnected graph with K5": {
+			vertices: []int{1, 2, 3, 4, 5, 6, 7},
+			edges: append(completeEdges(1, 2, 3, 4, 5),
+				Edge[int]{Source: 6, Target: 7},
+			),
+			expectedPlanar: false,
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		planar, err := IsPlanar(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if planar != test.expectedPlanar {
+			t.Errorf("%s: planarity expectancy doesn't match: expected %v, got %v", name, test.expectedPlanar, planar)
+		}
+	}
+}
+
+func TestKuratowskiSubgraph(t *testing.T) {
+	tests := map[string]struct {
+		vertices      []int
+		edges         []Edge[int]
+		expectedEdges int
+	}{
+		"planar graph": {
+			vertices:      []int{1, 2, 3, 4},
+			edges:         completeEdges(1, 2, 3, 4),
+			expectedEdges: 0,
+		},
+		"K5 with pendant vertex": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: append(completeEdges(1, 2, 3, 4, 5),
+				Edge[int]{Source: 5, Target: 6},
+			),
+			expectedEdges: 10,
+		},
+		"K6": {
+			vertices:      []int{1, 2, 3, 4, 5, 6},
+			edges:         completeEdges(1, 2, 3, 4, 5, 6),
+			expectedEdges: 10,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		witness, err := KuratowskiSubgraph(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if len(witness) != test.expectedEdges {
+			t.Errorf("%s: witness size expectancy doesn't match: expected %v, got %v (%v)", name, test.expectedEdges, len(witness), witness)
+		}
+
+		if len(witness) == 0 {
+			continue
+		}
+
+		subgraph := New(IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = subgraph.AddVertex(vertex)
+		}
+
+		for _, edge := range witness {
+			_ = subgraph.AddEdge(edge.Source, edge.Target)
+		}
+
+		if planar, _ := IsPlanar(subgraph); planar {
+			t.Errorf("%s: witness is planar", name)
+		}
+	}
+}
+
+func completeEdges(vertices ...int) []Edge[int] {
+	edges := make([]Edge[int], 0)
+
+	for i := range vertices {
+		for j := i + 1; j < len(vertices); j++ {
+			edges = append(edges, Edge[int]{Source: vertices[i], Target: vertices[j]})
+		}
+	}
+
+	return edges
+}
+
+func completeBipartiteEdges(left, right []int) []Edge[int] {
+	edges := make([]Edge[int], 0)
+
+	for _, l := range left {
+		for _, r := range right {
+			edges = append(edges, Edge[int]{Source: l, Target: r})
+		}
+	}
+
+	return edges
+}
@@ -0,0 +1,94 @@
+package graph
+
+// readOnly is a view on another graph that rejects all modifications.
+type readOnly[K comparable, T any] struct {
+	g Graph[K, T]
+}
+
+// ReadOnly returns a read-only view on the given graph. All methods that would modify the graph,
+// such as AddVertex, AddEdge, RemoveEdge, and MapEdgeWeights, return ErrReadOnly, while all other
+// methods are delegated to the underlying graph. This makes it safe to pass a graph to code that
+// is only supposed to read it.
+//
+// The view reflects changes made to the underlying graph. Cloning the view yields an independent,
+// modifiable copy of the underlying graph.
+func ReadOnly[K comparable, T any](g Graph[K, T]) Graph[K, T] {
+	if _, ok := g.(*readOnly[K, T]); ok {
+		return g
+	}
+
+	return &readOnly[K, T]{
+		g: g,
+	}
+}
+
+// Traits returns a copy of the traits of the underlying graph, so that they can't be modified
+// through the view.
+func (r *readOnly[K, T]) Traits() *Traits {
+	traits := *r.g.Traits()
+	return &traits
+}
+
+func (r *readOnly[K, T]) AddVertex(_ T) error {
+	return ErrReadOnly
+}
+
+func (r *readOnly[K, T]) Vertex(hash K) (T, error) {
+	return r.g.Vertex(hash)
+}
+
+func (r *readOnly[K, T]) HasVertex(hash K) bool {
+	return r.g.HasVertex(hash)
+}
+
+func (r *readOnly[K, T]) AddEdge(_, _ K, _ ...func(*EdgeProperties)) error {
+	return ErrReadOnly
+}
+
+func (r *readOnly[K, T]) Edge(sourceHash, targetHash K) (Edge[T], error) {
+	return r.g.Edge(sourceHash, targetHash)
+}
+
+func (r *readOnly[K, T]) HasEdge(sourceHash, targetHash K) bool {
+	return r.g.HasEdge(sourceHash, targetHash)
+}
+
+func (r *readOnly[K, T]) LookupEdge(sourceHash, targetHash K) (Edge[T], bool) {
+	return r.g.LookupEdge(sourceHash, targetHash)
+}
+
+func (r *readOnly[K, T]) RemoveEdge(_, _ K) error {
+	return ErrReadOnly
+}
+
+func (r *readOnly[K, T]) MapEdgeWeights(_ func(source, target K, weight int) int) error {
+	return ErrReadOnly
+}
+
+func (r *readOnly[K, T]) AdjacencyMap() (map[K]map[K]Edge[K], error) {
+	return r.g.AdjacencyMap()
+}
+
+func (r *readOnly[K, T]) PredecessorMap() (map[K]map[K]Edge[K], error) {
+	return r.g.PredecessorMap()
+}
+
+func (r *readOnly[K, T]) PredecessorCount(hash K) (int, error) {
+	return r.g.PredecessorCount(hash)
+}
+
+func (r *readOnly[K, T]) SuccessorCount(hash K) (int, error) {
+	return r.g.SuccessorCount(hash)
+}
+
+func (r *readOnly[K, T]) Clone() (Graph[K, T], error) {
+	return r.g.Clone()
+}
+
+func (r *readOnly[K, T]) Order() int {
+	return r.g.Order()
+}
+
+func (r *readOnly[K, T]) Size() int {
+	return r.g.Size()
+}
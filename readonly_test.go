@@ -0,0 +1,134 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReadOnly(t *testing.T) {
+	tests := map[string]struct {
+		traits []func(*Traits)
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed(), Weighted()},
+		},
+		"undirected graph": {
+			traits: []func(*Traits){Weighted()},
+		},
+	}
+
+	for name, test := range tests {
+		g := New(IntHash, test.traits...)
+		_ = g.AddVertex(1)
+		_ = g.AddVertex(2)
+		_ = g.AddEdge(1, 2, EdgeWeight(3))
+
+		view := ReadOnly(g)
+
+		if err := view.AddVertex(3); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("%s: AddVertex error expectancy doesn't match: expected %v, got %v", name, ErrReadOnly, err)
+		}
+
+		if err := view.AddEdge(2, 1); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("%s: AddEdge error expectancy doesn't match: expected %v, got %v", name, ErrReadOnly, err)
+		}
+
+		if err := view.RemoveEdge(1, 2); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("%s: RemoveEdge error expectancy doesn't match: expected %v, got %v", name, ErrReadOnly, err)
+		}
+
+		err := view.MapEdgeWeights(func(_, _ int, weight int) int { return 2 * weight })
+		if !errors.Is(err, ErrReadOnly) {
+			t.Errorf("%s: MapEdgeWeights error expectancy doesn't match: expected %v, got %v", name, ErrReadOnly, err)
+		}
+
+		view.Traits().IsDirected = !view.Traits().IsDirected
+
+		if g.Traits().IsDirected != view.Traits().IsDirected {
+			t.Errorf("%s: traits have been modified through the view", name)
+		}
+
+		if g.Order() != 2 || g.Size() != 1 {
+			t.Errorf("%s: graph has been modified: order %d, size %d", name, g.Order(), g.Size())
+		}
+
+		edge, err := view.Edge(1, 2)
+		if err != nil {
+			t.Fatalf("%s: failed to get edge: %s", name, err.Error())
+		}
+
+		if edge.Properties.Weight != 3 {
+			t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, 3, edge.Properties.Weight)
+		}
+
+		if !view.HasVertex(1) || !view.HasEdge(1, 2) {
+			t.Errorf("%s: view doesn't contain the vertices and edges of the graph", name)
+		}
+
+		if edge, ok := view.LookupEdge(1, 2); !ok || edge.Properties.Weight != 3 {
+			t.Errorf("%s: edge lookup expectancy doesn't match: expected %v with weight %v, got %v with weight %v", name, true, 3, ok, edge.Properties.Weight)
+		}
+
+		if count, _ := view.SuccessorCount(1); count != 1 {
+			t.Errorf("%s: successor count expectancy doesn't match: expected %v, got %v", name, 1, count)
+		}
+
+		if count, _ := view.PredecessorCount(2); count != 1 {
+			t.Errorf("%s: predecessor count expectancy doesn't match: expected %v, got %v", name, 1, count)
+		}
+
+		// The view reflects changes made to the underlying graph.
+		_ = g.AddVertex(3)
+
+		if view.Order() != 3 {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, 3, view.Order())
+		}
+
+		clone, err := view.Clone()
+		if err != nil {
+			t.Fatalf("%s: failed to clone view: %s", name, err.Error())
+		}
+
+		if err := clone.AddVertex(4); err != nil {
+			t.Errorf("%s: failed to add vertex to clone: %s", name, err.Error())
+		}
+
+		if view.HasVertex(4) {
+			t.Errorf("%s: clone isn't independent of the view", name)
+		}
+	}
+}
+
+func TestReadOnly_Algorithms(t *testing.T) {
+	g := New(IntHash, Directed(), Acyclic())
+
+	for i := 1; i <= 3; i++ {
+		_ = g.AddVertex(i)
+	}
+	_ = g.AddEdge(1, 2)
+	_ = g.AddEdge(2, 3)
+
+	view := ReadOnly(g)
+
+	order, err := TopologicalSort(view)
+	if err != nil {
+		t.Fatalf("failed to sort view: %s", err.Error())
+	}
+
+	if !orderedSlicesAreEqual(order, []int{1, 2, 3}) {
+		t.Errorf("order expectancy doesn't match: expected %v, got %v", []int{1, 2, 3}, order)
+	}
+
+	simplified, err := Simplify(view, nil)
+	if err != nil {
+		t.Fatalf("failed to simplify view: %s", err.Error())
+	}
+
+	if err := simplified.AddEdge(1, 3); err != nil {
+		t.Errorf("failed to add edge to simplified graph: %s", err.Error())
+	}
+
+	if ReadOnly(view) != view {
+		t.Error("wrapping a read-only view again should return the same view")
+	}
+}
@@ -0,0 +1,72 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DominatingSetApprox computes a small dominating set of an undirected graph and returns the
+// hashes of its vertices. In a dominating set, every vertex of the graph either is part of the set
+// or is adjacent to a vertex of the set. Isolated vertices are always part of the dominating set.
+//
+// Finding a minimum dominating set is NP-hard. DominatingSetApprox uses the greedy set cover
+// heuristic instead, which repeatedly picks the vertex that dominates the most vertices that are
+// not dominated yet. The resulting set is at most ln(n)+1 times larger than a minimum dominating
+// set. The vertices are returned in the order in which they have been picked.
+func DominatingSetApprox[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	if g.Traits().IsDirected {
+		return nil, errors.New("dominating sets can only be computed for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	// Sorting the vertices makes ties between equally good vertices deterministic.
+	sortKeys(vertices)
+
+	undominated := make(map[K]bool, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		undominated[vertex] = true
+	}
+
+	dominatingSet := make([]K, 0)
+
+	for len(undominated) > 0 {
+		var best K
+		bestGain := 0
+
+		for _, vertex := range vertices {
+			gain := 0
+			if undominated[vertex] {
+				gain++
+			}
+
+			for adjacency := range adjacencyMap[vertex] {
+				if adjacency != vertex && undominated[adjacency] {
+					gain++
+				}
+			}
+
+			if gain > bestGain {
+				best = vertex
+				bestGain = gain
+			}
+		}
+
+		dominatingSet = append(dominatingSet, best)
+
+		delete(undominated, best)
+		for adjacency := range adjacencyMap[best] {
+			delete(undominated, adjacency)
+		}
+	}
+
+	return dominatingSet, nil
+}
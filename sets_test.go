@@ -0,0 +1,107 @@
+package graph
+
+import "testing"
+
+func TestDominatingSetApprox(t *testing.T) {
+	tests := map[string]struct {
+		isDirected  bool
+		vertices    []int
+		edges       []Edge[int]
+		expectedSet []int
+		shouldFail  bool
+	}{
+		"star": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 1, Target: 5},
+			},
+			expectedSet: []int{1},
+		},
+		"path": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 6},
+			},
+			expectedSet: []int{2, 5},
+		},
+		"isolated vertices": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedSet: []int{1, 3, 4},
+		},
+		"empty graph": {
+			expectedSet: []int{},
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		dominatingSet, err := DominatingSetApprox(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !slicesAreEqual(dominatingSet, test.expectedSet) {
+			t.Errorf("%s: dominating set expectancy doesn't match: expected %v, got %v", name, test.expectedSet, dominatingSet)
+		}
+
+		inSet := make(map[int]bool)
+		dominated := make(map[int]bool)
+
+		for _, vertex := range dominatingSet {
+			inSet[vertex] = true
+			dominated[vertex] = true
+		}
+
+		for _, edge := range test.edges {
+			if inSet[edge.Source] || inSet[edge.Target] {
+				dominated[edge.Source] = true
+				dominated[edge.Target] = true
+			}
+		}
+
+		for _, vertex := range test.vertices {
+			if !dominated[vertex] {
+				t.Errorf("%s: vertex %v is not dominated", name, vertex)
+			}
+		}
+	}
+}
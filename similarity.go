@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// JaccardSimilarity computes the Jaccard similarity of the neighbourhoods of two vertices, which is
+// the number of their common neighbours divided by the number of vertices that are a neighbour of
+// at least one of them. The similarity ranges from 0 for vertices without common neighbours to 1
+// for vertices with identical neighbourhoods, and is a common feature for link prediction.
+//
+// In a directed graph, the neighbours of a vertex are its successors, i.e. the targets of its
+// outgoing edges. Self-loops are ignored, so a vertex is never its own neighbour. If neither vertex
+// has any neighbours, the similarity is 0.
+func JaccardSimilarity[K comparable, T any](g Graph[K, T], a, b K) (float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if err := checkVertices(adjacencyMap, a, b); err != nil {
+		return 0, err
+	}
+
+	common := commonNeighbours(adjacencyMap, a, b)
+	union := neighbourCount(adjacencyMap, a) + neighbourCount(adjacencyMap, b) - len(common)
+
+	if union == 0 {
+		return 0, nil
+	}
+
+	return float64(len(common)) / float64(union), nil
+}
+
+// AdamicAdar computes the Adamic-Adar index of two vertices, which sums up 1/log(d) over all common
+// neighbours of the vertices, where d is the number of neighbours of the common neighbour. Unlike
+// the number of common neighbours, the index weights rare common neighbours higher than common
+// neighbours that are adjacent to many vertices.
+//
+// Like in JaccardSimilarity, the neighbours of a vertex in a directed graph are its successors and
+// self-loops are ignored. The degree d of a common neighbour, however, is the number of all of its
+// neighbours regardless of the edge directions, which is at least 2 for two distinct vertices. If
+// the vertices don't have any common neighbours, the index is 0.
+func AdamicAdar[K comparable, T any](g Graph[K, T], a, b K) (float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if err := checkVertices(adjacencyMap, a, b); err != nil {
+		return 0, err
+	}
+
+	common := commonNeighbours(adjacencyMap, a, b)
+	if len(common) == 0 {
+		return 0, nil
+	}
+
+	neighbours, err := undirectedNeighbours(g)
+	if err != nil {
+		return 0, err
+	}
+
+	index := 0.0
+
+	for _, vertex := range common {
+		degree := len(neighbours[vertex])
+		if neighbours[vertex][vertex] {
+			degree--
+		}
+
+		// A common neighbour of a vertex with itself may have no other neighbours, and since
+		// log(1) is 0, it doesn't contribute to the index.
+		if degree < 2 {
+			continue
+		}
+
+		index += 1 / math.Log(float64(degree))
+	}
+
+	return index, nil
+}
+
+// checkVertices returns an error if one of the given vertices doesn't exist.
+func checkVertices[K comparable](adjacencyMap map[K]map[K]Edge[K], vertices ...K) error {
+	for _, vertex := range vertices {
+		if _, ok := adjacencyMap[vertex]; !ok {
+			return fmt.Errorf("could not find vertex with hash %v", vertex)
+		}
+	}
+
+	return nil
+}
+
+// commonNeighbours returns the vertices that are adjacent to both a and b, sorted by their hashes
+// so that floating-point sums over them are deterministic. Self-loops are ignored.
+func commonNeighbours[K comparable](adjacencyMap map[K]map[K]Edge[K], a, b K) []K {
+	common := make([]K, 0)
+
+	for adjacency := range adjacencyMap[a] {
+		// Since self-loops are ignored, neither a nor b can be a common neighbour.
+		if adjacency == a || adjacency == b {
+			continue
+		}
+		if _, ok := adjacencyMap[b][adjacency]; ok {
+			common = append(common, adjacency)
+		}
+	}
+
+	sortKeys(common)
+
+	return common
+}
+
+// neighbourCount returns the number of vertices adjacent to the given vertex, ignoring self-loops.
+func neighbourCount[K comparable](adjacencyMap map[K]map[K]Edge[K], vertex K) int {
+	count := len(adjacencyMap[vertex])
+	if _, ok := adjacencyMap[vertex][vertex]; ok {
+		count--
+	}
+
+	return count
+}
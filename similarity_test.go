@@ -0,0 +1,220 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestJaccardSimilarity(t *testing.T) {
+	tests := map[string]struct {
+		isDirected         bool
+		vertices           []int
+		edges              []Edge[int]
+		a                  int
+		b                  int
+		expectedSimilarity float64
+		shouldFail         bool
+	}{
+		"partially overlapping neighbourhoods": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 4},
+				{Source: 2, Target: 5},
+			},
+			a:                  1,
+			b:                  2,
+			expectedSimilarity: 1.0 / 3.0,
+		},
+		"identical neighbourhoods": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+			},
+			a:                  1,
+			b:                  2,
+			expectedSimilarity: 1,
+		},
+		"adjacent vertices": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+			},
+			a:                  1,
+			b:                  2,
+			expectedSimilarity: 1.0 / 3.0,
+		},
+		"self-loops are ignored": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+			},
+			a:                  1,
+			b:                  2,
+			expectedSimilarity: 1,
+		},
+		"directed graph uses successors": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+				{Source: 4, Target: 1},
+				{Source: 2, Target: 4},
+			},
+			a:                  1,
+			b:                  2,
+			expectedSimilarity: 0.5,
+		},
+		"vertices without neighbours": {
+			vertices:           []int{1, 2},
+			a:                  1,
+			b:                  2,
+			expectedSimilarity: 0,
+		},
+		"unknown vertex": {
+			vertices:   []int{1},
+			a:          1,
+			b:          2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		similarity, err := JaccardSimilarity(graph, test.a, test.b)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if math.Abs(similarity-test.expectedSimilarity) > 1e-9 {
+			t.Errorf("%s: similarity expectancy doesn't match: expected %v, got %v", name, test.expectedSimilarity, similarity)
+		}
+	}
+}
+
+func TestAdamicAdar(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []int
+		edges         []Edge[int]
+		a             int
+		b             int
+		expectedIndex float64
+		shouldFail    bool
+	}{
+		"common neighbours with different degrees": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 4, Target: 6},
+			},
+			a:             1,
+			b:             2,
+			expectedIndex: 1/math.Log(2) + 1/math.Log(4),
+		},
+		"directed graph counts all neighbours of common neighbours": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			a:             1,
+			b:             2,
+			expectedIndex: 1 / math.Log(3),
+		},
+		"no common neighbours": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+			},
+			a:             1,
+			b:             2,
+			expectedIndex: 0,
+		},
+		"vertex with itself": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			a:             1,
+			b:             1,
+			expectedIndex: 0,
+		},
+		"unknown vertex": {
+			vertices:   []int{1},
+			a:          2,
+			b:          1,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		index, err := AdamicAdar(graph, test.a, test.b)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if math.IsNaN(index) || math.Abs(index-test.expectedIndex) > 1e-9 {
+			t.Errorf("%s: index expectancy doesn't match: expected %v, got %v", name, test.expectedIndex, index)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SortedAdjacencyMap computes a deterministic view of the adjacency map. It returns the hashes of
+// all vertices sorted using the given less function, along with a map that contains the outgoing
+// edges of each vertex as a slice, sorted by their target hashes using the same function.
+//
+// Because the adjacency map is a Go map, iterating over it yields the vertices and edges in a
+// different order each time. This makes algorithms that have multiple valid results, such as a
+// shortest path among several paths of equal length, non-deterministic. Iterating over the sorted
+// vertices and edges instead gives reproducible results without changing how the graph is stored:
+//
+//	vertices, edges, _ := graph.SortedAdjacencyMap(g, func(a, b int) bool {
+//		return a < b
+//	})
+//
+//	for _, vertex := range vertices {
+//		for _, edge := range edges[vertex] {
+//			fmt.Println(edge.Source, edge.Target)
+//		}
+//	}
+//
+// For an undirected graph, each edge is contained in the edge slices of both of its vertices.
+func SortedAdjacencyMap[K comparable, T any](g Graph[K, T], less func(a, b K) bool) ([]K, map[K][]Edge[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	edges := make(map[K][]Edge[K], len(adjacencyMap))
+
+	for vertex, adjacencies := range adjacencyMap {
+		vertices = append(vertices, vertex)
+
+		vertexEdges := make([]Edge[K], 0, len(adjacencies))
+		for _, edge := range adjacencies {
+			vertexEdges = append(vertexEdges, edge)
+		}
+
+		sort.Slice(vertexEdges, func(i, j int) bool {
+			return less(vertexEdges[i].Target, vertexEdges[j].Target)
+		})
+
+		edges[vertex] = vertexEdges
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return less(vertices[i], vertices[j])
+	})
+
+	return vertices, edges, nil
+}
+
+// SortedVertices returns the hashes of all vertices in the graph sorted using the given less
+// function. If less is nil, the hashes are sorted in their natural order if they are of a numeric
+// or string type, and by their string representation otherwise.
+//
+// This is useful for stable output and reproducible tests, since iterating over the adjacency map
+// yields the vertices in a different order each time.
+func SortedVertices[K comparable, T any](g Graph[K, T], less func(a, b K) bool) ([]K, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if less == nil {
+		return sortedMapKeys(adjacencyMap), nil
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return less(vertices[i], vertices[j])
+	})
+
+	return vertices, nil
+}
+
+// VerticesInInsertionOrder returns the hashes of all vertices in the order in which they have been
+// added to the graph. Adding a vertex that already exists doesn't change its position. The order
+// is preserved by Clone, so that a cloned graph yields the same order as the original graph.
+//
+// The insertion order is tracked by the graphs created using New, including read-only views of
+// them. For other Graph implementations, an error will be returned.
+func VerticesInInsertionOrder[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	sequence, err := insertionSequenceOf(g)
+	if err != nil {
+		return nil, err
+	}
+
+	return sequence.orderedVertices(), nil
+}
+
+// EdgesInInsertionOrder returns all edges of the graph in the order in which they have been added.
+// Each edge is returned with the source and target hashes it has been added with, which matters
+// for undirected graphs, and with its current weight and attributes. An edge that has been removed
+// and added again is positioned according to the time it has been added again.
+//
+// Just like VerticesInInsertionOrder, EdgesInInsertionOrder only supports the graphs created using
+// New and read-only views of them.
+func EdgesInInsertionOrder[K comparable, T any](g Graph[K, T]) ([]Edge[K], error) {
+	sequence, err := insertionSequenceOf(g)
+	if err != nil {
+		return nil, err
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	orderedEdges := sequence.orderedEdges()
+	edges := make([]Edge[K], 0, len(orderedEdges))
+
+	for _, edge := range orderedEdges {
+		edges = append(edges, adjacencyMap[edge[0]][edge[1]])
+	}
+
+	return edges, nil
+}
+
+// insertionSequenceOf returns the insertion sequence recorded by the given graph.
+func insertionSequenceOf[K comparable, T any](g Graph[K, T]) (*insertionSequence[K], error) {
+	switch typedGraph := g.(type) {
+	case *directed[K, T]:
+		return typedGraph.insertion, nil
+	case *undirected[K, T]:
+		return typedGraph.insertion, nil
+	case *readOnly[K, T]:
+		return insertionSequenceOf(typedGraph.g)
+	}
+
+	return nil, fmt.Errorf("graph of type %T doesn't track the insertion order", g)
+}
+
+// EdgesByWeight returns all edges of the graph sorted by their weight, in ascending order if
+// ascending is true and in descending order otherwise. Edges with the same weight are sorted in
+// ascending order of their source and target hashes, regardless of the weight order, which makes
+// the result deterministic. For an undirected graph, each edge is only returned once, namely with
+// the smaller hash as source.
+//
+// Integer, float, and string hashes are sorted by their natural order, all other hashes are sorted
+// by their string representation.
+func EdgesByWeight[K comparable, T any](g Graph[K, T], ascending bool) ([]Edge[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	return edgesByWeight(adjacencyMap, g.Traits().IsDirected, ascending), nil
+}
+
+// EdgesWithAttribute returns all edges of the graph that have an attribute with the given key and
+// value, sorted in ascending order of their source and target hashes. Edges that don't have the
+// attribute at all don't match. For an undirected graph, each edge is only returned once, namely
+// with the smaller hash as source.
+//
+//	highways, _ := graph.EdgesWithAttribute(g, "type", "highway")
+func EdgesWithAttribute[K comparable, T any](g Graph[K, T], key, value string) ([]Edge[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	edges := make([]Edge[K], 0)
+
+	for _, edge := range sortedEdges(adjacencyMap, g.Traits().IsDirected) {
+		if attribute, ok := edge.Properties.Attributes[key]; ok && attribute == value {
+			edges = append(edges, edge)
+		}
+	}
+
+	return edges, nil
+}
+
+func edgesByWeight[K comparable](adjacencyMap map[K]map[K]Edge[K], isDirected, ascending bool) []Edge[K] {
+	edges := sortedEdges(adjacencyMap, isDirected)
+
+	sort.SliceStable(edges, func(i, j int) bool {
+		if ascending {
+			return edges[i].Properties.Weight < edges[j].Properties.Weight
+		}
+		return edges[i].Properties.Weight > edges[j].Properties.Weight
+	})
+
+	return edges
+}
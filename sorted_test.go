@@ -0,0 +1,444 @@
+package graph
+
+import "testing"
+
+func TestSortedAdjacencyMap(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		vertices         []int
+		edges            []Edge[int]
+		less             func(a, b int) bool
+		expectedVertices []int
+		expectedTargets  map[int][]int
+	}{
+		"directed graph in ascending order": {
+			isDirected: true,
+			vertices:   []int{3, 1, 4, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 4},
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 2},
+			},
+			less: func(a, b int) bool {
+				return a < b
+			},
+			expectedVertices: []int{1, 2, 3, 4},
+			expectedTargets: map[int][]int{
+				1: {2, 3, 4},
+				2: {},
+				3: {2},
+				4: {},
+			},
+		},
+		"undirected graph in descending order": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+			},
+			less: func(a, b int) bool {
+				return a > b
+			},
+			expectedVertices: []int{3, 2, 1},
+			expectedTargets: map[int][]int{
+				1: {3, 2},
+				2: {1},
+				3: {1},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		vertices, edges, err := SortedAdjacencyMap(graph, test.less)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if !orderedSlicesAreEqual(vertices, test.expectedVertices) {
+			t.Errorf("%s: vertices expectancy doesn't match: expected %v, got %v", name, test.expectedVertices, vertices)
+		}
+
+		for vertex, expectedTargets := range test.expectedTargets {
+			targets := make([]int, 0, len(edges[vertex]))
+			for _, edge := range edges[vertex] {
+				if edge.Source != vertex {
+					t.Errorf("%s: edge source expectancy doesn't match: expected %v, got %v", name, vertex, edge.Source)
+				}
+				targets = append(targets, edge.Target)
+			}
+
+			if !orderedSlicesAreEqual(targets, expectedTargets) {
+				t.Errorf("%s: targets expectancy of %v doesn't match: expected %v, got %v", name, vertex, expectedTargets, targets)
+			}
+		}
+	}
+}
+
+// orderedSlicesAreEqual determines whether two slices contain the same elements in the same order.
+func TestSortedVertices(t *testing.T) {
+	tests := map[string]struct {
+		vertices         []int
+		less             func(a, b int) bool
+		expectedVertices []int
+	}{
+		"ascending order": {
+			vertices:         []int{3, 1, 4, 5, 2},
+			less:             func(a, b int) bool { return a < b },
+			expectedVertices: []int{1, 2, 3, 4, 5},
+		},
+		"descending order": {
+			vertices:         []int{3, 1, 4, 5, 2},
+			less:             func(a, b int) bool { return a > b },
+			expectedVertices: []int{5, 4, 3, 2, 1},
+		},
+		"default order": {
+			vertices:         []int{10, -1, 2},
+			expectedVertices: []int{-1, 2, 10},
+		},
+		"empty graph": {
+			vertices:         []int{},
+			expectedVertices: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		vertices, err := SortedVertices(graph, test.less)
+		if err != nil {
+			t.Fatalf("%s: failed to sort vertices: %s", name, err.Error())
+		}
+
+		if !orderedSlicesAreEqual(vertices, test.expectedVertices) {
+			t.Errorf("%s: vertex expectancy doesn't match: expected %v, got %v", name, test.expectedVertices, vertices)
+		}
+	}
+}
+
+func orderedSlicesAreEqual[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestEdgesByWeight(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []string
+		edges         []Edge[string]
+		ascending     bool
+		expectedEdges []Edge[string]
+	}{
+		"ascending undirected graph": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "A", Properties: EdgeProperties{Weight: 5}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "B", Properties: EdgeProperties{Weight: 1}},
+			},
+			ascending: true,
+			expectedEdges: []Edge[string]{
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 5}},
+			},
+		},
+		"descending directed graph": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "B", Target: "A", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "C", Target: "A", Properties: EdgeProperties{Weight: 7}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: -1}},
+			},
+			ascending: false,
+			expectedEdges: []Edge[string]{
+				{Source: "C", Target: "A", Properties: EdgeProperties{Weight: 7}},
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "A", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: -1}},
+			},
+		},
+		"graph without edges": {
+			vertices:      []string{"A"},
+			expectedEdges: []Edge[string]{},
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[string, string]
+		if test.isDirected {
+			graph = New(StringHash, Directed(), Weighted())
+		} else {
+			graph = New(StringHash, Weighted())
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		edges, err := EdgesByWeight(graph, test.ascending)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if len(edges) != len(test.expectedEdges) {
+			t.Fatalf("%s: edge count expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), len(edges))
+		}
+
+		for i, expectedEdge := range test.expectedEdges {
+			edge := edges[i]
+			if edge.Source != expectedEdge.Source || edge.Target != expectedEdge.Target || edge.Properties.Weight != expectedEdge.Properties.Weight {
+				t.Errorf("%s: edge expectancy at index %d doesn't match: expected %v, got %v", name, i, expectedEdge, edge)
+			}
+		}
+	}
+}
+
+func TestEdgesWithAttribute(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []int
+		edges         []Edge[int]
+		key           string
+		value         string
+		expectedEdges [][2]int
+	}{
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 2, Target: 1, Properties: EdgeProperties{Attributes: map[string]string{"color": "red"}}},
+				{Source: 1, Target: 2, Properties: EdgeProperties{Attributes: map[string]string{"color": "red"}}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Attributes: map[string]string{"color": "blue"}}},
+				{Source: 3, Target: 2},
+			},
+			key:           "color",
+			value:         "red",
+			expectedEdges: [][2]int{{1, 2}, {2, 1}},
+		},
+		"undirected graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 3, Target: 1, Properties: EdgeProperties{Attributes: map[string]string{"type": "highway"}}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Attributes: map[string]string{"type": "road"}}},
+			},
+			key:           "type",
+			value:         "highway",
+			expectedEdges: [][2]int{{1, 3}},
+		},
+		"empty value": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Attributes: map[string]string{"label": ""}}},
+				{Source: 2, Target: 3},
+			},
+			key:           "label",
+			value:         "",
+			expectedEdges: [][2]int{{1, 2}},
+		},
+		"no matching edges": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Attributes: map[string]string{"color": "red"}}},
+			},
+			key:           "weight",
+			value:         "red",
+			expectedEdges: [][2]int{},
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			var edgeOptions []func(*EdgeProperties)
+			for key, value := range edge.Properties.Attributes {
+				edgeOptions = append(edgeOptions, EdgeAttribute(key, value))
+			}
+
+			if err := graph.AddEdge(edge.Source, edge.Target, edgeOptions...); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		edges, err := EdgesWithAttribute(graph, test.key, test.value)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		pairs := make([][2]int, 0, len(edges))
+		for _, edge := range edges {
+			pairs = append(pairs, [2]int{edge.Source, edge.Target})
+		}
+
+		if !orderedSlicesAreEqual(pairs, test.expectedEdges) {
+			t.Errorf("%s: edges expectancy doesn't match: expected %v, got %v", name, test.expectedEdges, pairs)
+		}
+	}
+}
+
+func TestInsertionOrder(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		vertices         []int
+		edges            []Edge[int]
+		removedEdges     []Edge[int]
+		addedEdges       []Edge[int]
+		expectedVertices []int
+		expectedEdges    [][2]int
+	}{
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{5, 3, 9, 1, 3},
+			edges: []Edge[int]{
+				{Source: 9, Target: 1},
+				{Source: 5, Target: 3},
+				{Source: 3, Target: 5},
+				{Source: 1, Target: 5},
+			},
+			removedEdges:     []Edge[int]{{Source: 3, Target: 5}},
+			addedEdges:       []Edge[int]{{Source: 3, Target: 9}},
+			expectedVertices: []int{5, 3, 9, 1},
+			expectedEdges:    [][2]int{{9, 1}, {5, 3}, {1, 5}, {3, 9}},
+		},
+		"undirected graph keeps edge orientation": {
+			vertices: []int{4, 2, 8},
+			edges: []Edge[int]{
+				{Source: 8, Target: 2},
+				{Source: 4, Target: 8},
+				{Source: 2, Target: 4},
+			},
+			removedEdges:     []Edge[int]{{Source: 2, Target: 8}},
+			addedEdges:       []Edge[int]{{Source: 8, Target: 2}},
+			expectedVertices: []int{4, 2, 8},
+			expectedEdges:    [][2]int{{4, 8}, {2, 4}, {8, 2}},
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		for _, edge := range test.removedEdges {
+			if err := graph.RemoveEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to remove edge: %s", name, err.Error())
+			}
+		}
+
+		for _, edge := range test.addedEdges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		clone, err := graph.Clone()
+		if err != nil {
+			t.Fatalf("%s: failed to clone graph: %s", name, err.Error())
+		}
+
+		// The order has to be the same for the graph, a clone of it, and a read-only view.
+		for _, g := range []Graph[int, int]{graph, clone, ReadOnly(graph)} {
+			vertices, err := VerticesInInsertionOrder(g)
+			if err != nil {
+				t.Fatalf("%s: failed to get vertices: %s", name, err.Error())
+			}
+
+			if !orderedSlicesAreEqual(vertices, test.expectedVertices) {
+				t.Errorf("%s: vertex order expectancy doesn't match: expected %v, got %v", name, test.expectedVertices, vertices)
+			}
+
+			edges, err := EdgesInInsertionOrder(g)
+			if err != nil {
+				t.Fatalf("%s: failed to get edges: %s", name, err.Error())
+			}
+
+			if len(edges) != len(test.expectedEdges) {
+				t.Fatalf("%s: number of edges doesn't match: expected %v, got %v", name, len(test.expectedEdges), len(edges))
+			}
+
+			for i, edge := range edges {
+				if edge.Source != test.expectedEdges[i][0] || edge.Target != test.expectedEdges[i][1] {
+					t.Errorf("%s: edge order expectancy doesn't match: expected %v, got %v", name, test.expectedEdges, edges)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestInsertionOrder_UnsupportedGraph(t *testing.T) {
+	graph := &manipulatedGraph{Graph: New(IntHash)}
+
+	if _, err := VerticesInInsertionOrder[int, int](graph); err == nil {
+		t.Error("error expectancy doesn't match: expected an error, got nil")
+	}
+
+	if _, err := EdgesInInsertionOrder[int, int](graph); err == nil {
+		t.Error("error expectancy doesn't match: expected an error, got nil")
+	}
+}
@@ -1,5 +1,7 @@
 package graph
 
+import "fmt"
+
 // Traits represents a set of graph traits and types, such as directedness or acyclicness. These
 // traits can be set when creating a graph by passing the corresponding functional options, for
 // example:
@@ -7,6 +9,12 @@ package graph
 //	g := graph.New(graph.IntHash, graph.Directed())
 //
 // This will set the IsDirected field to true.
+//
+// The traits can be combined with each other, with one exception: A rooted graph has to be acyclic,
+// since all functions working with rooted graphs, such as EulerTour, expect a tree. The meaning of
+// the Acyclic trait depends on the directedness: A directed acyclic graph is a DAG, whereas an
+// undirected acyclic graph is a forest, and an undirected rooted graph is an undirected tree. New
+// doesn't check the traits, but NewChecked rejects unsupported combinations.
 type Traits struct {
 	IsDirected bool
 	IsAcyclic  bool
@@ -50,3 +58,12 @@ func Tree() func(*Traits) {
 		Rooted()(t)
 	}
 }
+
+// validateTraits returns an error wrapping ErrInvalidTraits if the given traits can't be combined.
+func validateTraits(t Traits) error {
+	if t.IsRooted && !t.IsAcyclic {
+		return fmt.Errorf("%w: a rooted graph has to be acyclic", ErrInvalidTraits)
+	}
+
+	return nil
+}
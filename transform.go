@@ -0,0 +1,464 @@
+package graph
+
+import "fmt"
+
+// DeepClone creates an independent deep copy of the graph just like Clone does, but additionally
+// applies the given copy function to each vertex value. This is necessary if the vertex values are
+// pointers or contain slices or maps, because Clone only copies the values themselves and the
+// cloned graph would share the underlying data with the original graph.
+//
+//	clone, err := graph.DeepClone(g, func(c *City) *City {
+//		copied := *c
+//		return &copied
+//	})
+//
+// The copy function must return a value with the same hash as the original value. The attributes
+// of each edge are copied as well, so the cloned graph never shares any data with the original
+// one as long as the copy function returns independent copies.
+//
+// Optionally, functional options for the edge properties can be passed, which are applied to each
+// edge of the cloned graph after its weight and attributes have been copied. For example, all edges
+// of the clone can be tagged with their origin like so:
+//
+//	clone, err := graph.DeepClone(g, copyCity, graph.EdgeAttribute("source", "osm"))
+//
+// An attribute set this way overrides an existing attribute with the same key, while all other
+// attributes of the edge are kept. Likewise, EdgeWeight overrides the weight of each edge.
+func DeepClone[K comparable, T any](g Graph[K, T], copyValue func(T) T, options ...func(*EdgeProperties)) (Graph[K, T], error) {
+	clone, err := newLike(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create graph: %w", err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	for hash := range adjacencyMap {
+		vertex, err := g.Vertex(hash)
+		if err != nil {
+			return nil, fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+
+		if err := clone.AddVertex(copyValue(vertex)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex with hash %v: %w", hash, err)
+		}
+
+		if _, err := clone.Vertex(hash); err != nil {
+			return nil, fmt.Errorf("copy of vertex with hash %v has a different hash", hash)
+		}
+	}
+
+	if err := addEdges(clone, adjacencyMap, g.Traits().IsDirected, options...); err != nil {
+		return nil, err
+	}
+
+	return clone, nil
+}
+
+// addEdges adds all edges from the given adjacency map to the graph, including their weights and
+// copies of their attributes, and applies the given options to each of them. For an undirected
+// graph, each edge is only added once.
+func addEdges[K comparable, T any](g Graph[K, T], adjacencyMap map[K]map[K]Edge[K], isDirected bool, options ...func(*EdgeProperties)) error {
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			if !isDirected {
+				if _, err := g.Edge(source, target); err == nil {
+					continue
+				}
+			}
+			if err := g.AddEdge(source, target, copiedEdgeOptions(edge.Properties, options)...); err != nil {
+				return fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// copyEdgeProperties returns a functional option for AddEdge that sets the weight and a copy of the
+// attributes of the given edge properties.
+func copyEdgeProperties(properties EdgeProperties) func(*EdgeProperties) {
+	return func(p *EdgeProperties) {
+		p.Weight = properties.Weight
+
+		if p.Attributes == nil {
+			p.Attributes = make(map[string]string, len(properties.Attributes))
+		}
+
+		for key, value := range properties.Attributes {
+			p.Attributes[key] = value
+		}
+	}
+}
+
+// copiedEdgeOptions returns the functional options for adding a copy of an edge with the given
+// properties, followed by the given additional options so that these take precedence.
+func copiedEdgeOptions(properties EdgeProperties, options []func(*EdgeProperties)) []func(*EdgeProperties) {
+	return append([]func(*EdgeProperties){copyEdgeProperties(properties)}, options...)
+}
+
+// Merge adds all vertices and edges of the source graph src to the destination graph dst. Both
+// graphs must have the same directedness and weightedness, otherwise an error will be returned. If
+// dst is acyclic, the merged graph must not contain any cycles or self-loops either.
+//
+// If a vertex of src already exists in dst, onVertexConflict is called with the existing and the
+// incoming vertex value, and the returned value is stored in dst. It must have the same hash. If
+// onVertexConflict is nil, the incoming value replaces the existing one.
+//
+// Likewise, if an edge of src already exists in dst, onEdgeConflict is called with the properties
+// of the existing and of the incoming edge, and the edge is replaced with an edge that has the
+// returned properties. If onEdgeConflict is nil, the existing edge is kept.
+//
+// Merge modifies dst in place. The traits, the resolved vertex values, and the acyclicity of the
+// merged graph are checked before dst is modified, so dst remains unchanged if any of these checks
+// fails. If dst is a custom Graph implementation, the hashes of the resolved vertex values are not
+// checked. If adding a vertex or an edge fails nevertheless, dst may have been merged partially.
+func Merge[K comparable, T any](
+	dst, src Graph[K, T],
+	onVertexConflict func(existing, incoming T) T,
+	onEdgeConflict func(existing, incoming EdgeProperties) EdgeProperties,
+) error {
+	if dst.Traits().IsDirected != src.Traits().IsDirected {
+		return fmt.Errorf("cannot merge graphs with different directedness")
+	}
+
+	if dst.Traits().IsWeighted != src.Traits().IsWeighted {
+		return fmt.Errorf("cannot merge graphs with different weightedness")
+	}
+
+	adjacencyMap, err := src.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	dstAdjacencyMap, err := dst.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	// For unknown graph implementations, the hashes of the resolved vertices can't be checked.
+	hash, hasHashFunc := hashFunc(dst)
+
+	values := make(map[K]T, len(adjacencyMap))
+
+	for vertexHash := range adjacencyMap {
+		incoming, err := src.Vertex(vertexHash)
+		if err != nil {
+			return fmt.Errorf("could not get vertex with hash %v: %w", vertexHash, err)
+		}
+
+		value := incoming
+
+		if existing, err := dst.Vertex(vertexHash); err == nil && onVertexConflict != nil {
+			value = onVertexConflict(existing, incoming)
+		}
+
+		if hasHashFunc {
+			if resolvedHash := hash(value); resolvedHash != vertexHash {
+				return fmt.Errorf("resolved vertex for hash %v has a different hash %v", vertexHash, resolvedHash)
+			}
+		}
+
+		values[vertexHash] = value
+	}
+
+	if dst.Traits().IsAcyclic && mergeCreatesCycle(dstAdjacencyMap, adjacencyMap, dst.Traits().IsDirected) {
+		return fmt.Errorf("cannot merge graphs: %w", ErrEdgeCreatesCycle)
+	}
+
+	for _, vertexHash := range sortedMapKeys(values) {
+		if err := dst.AddVertex(values[vertexHash]); err != nil {
+			return fmt.Errorf("failed to add vertex with hash %v: %w", vertexHash, err)
+		}
+	}
+
+	// An undirected edge is contained in the adjacency map twice, but must only be merged once.
+	merged := make(map[K]map[K]bool)
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			if !src.Traits().IsDirected {
+				if merged[target][source] {
+					continue
+				}
+				if _, ok := merged[source]; !ok {
+					merged[source] = make(map[K]bool)
+				}
+				merged[source][target] = true
+			}
+
+			existing, err := dst.Edge(source, target)
+			if err != nil {
+				if err := dst.AddEdge(source, target, copyEdgeProperties(edge.Properties)); err != nil {
+					return fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+				}
+				continue
+			}
+
+			if onEdgeConflict == nil {
+				continue
+			}
+
+			properties := onEdgeConflict(existing.Properties, edge.Properties)
+
+			// Since edges cannot be updated in place, the existing edge is replaced with an edge
+			// that has the resolved properties.
+			if err := dst.RemoveEdge(source, target); err != nil {
+				return fmt.Errorf("failed to remove edge (%v, %v): %w", source, target, err)
+			}
+			if err := dst.AddEdge(source, target, copyEdgeProperties(properties)); err != nil {
+				return fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// mergeCreatesCycle determines whether the union of the two given adjacency maps contains a cycle
+// or a self-loop.
+func mergeCreatesCycle[K comparable](a, b map[K]map[K]Edge[K], isDirected bool) bool {
+	union := make(map[K]map[K]Edge[K], len(a)+len(b))
+
+	for _, adjacencyMap := range []map[K]map[K]Edge[K]{a, b} {
+		for source, adjacencies := range adjacencyMap {
+			if _, ok := union[source]; !ok {
+				union[source] = make(map[K]Edge[K])
+			}
+			for target, edge := range adjacencies {
+				if source == target {
+					return true
+				}
+				union[source][target] = edge
+			}
+		}
+	}
+
+	return containsCycle(union, isDirected)
+}
+
+// Simplify creates a simple graph from the given graph: Parallel edges between the same pair of
+// vertices are collapsed into a single edge, and self-loops are dropped. The properties of
+// collapsed edges are combined using the given combine function. The original graph remains
+// unchanged.
+//
+// In an undirected graph, the edges (u, v) and (v, u) are treated as the same edge. If the
+// adjacency map reports different properties for both directions, which may happen for custom
+// graph implementations, these properties are combined as well. If combine is nil, the properties
+// of the edge encountered first are kept.
+//
+// Graphs created using New don't allow parallel edges, so for them, Simplify only drops self-loops
+// and leaves all other edges as they are.
+func Simplify[K comparable, T any](g Graph[K, T], combine func(a, b EdgeProperties) EdgeProperties) (Graph[K, T], error) {
+	simplified, err := newLike(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create graph: %w", err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	for hash := range adjacencyMap {
+		vertex, err := g.Vertex(hash)
+		if err != nil {
+			return nil, fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+		if err := simplified.AddVertex(vertex); err != nil {
+			return nil, fmt.Errorf("failed to add vertex with hash %v: %w", hash, err)
+		}
+	}
+
+	isDirected := g.Traits().IsDirected
+
+	for _, edge := range sortedEdges(adjacencyMap, isDirected) {
+		if edge.Source == edge.Target {
+			continue
+		}
+
+		properties := edge.Properties
+
+		if !isDirected && combine != nil {
+			if reverse, ok := adjacencyMap[edge.Target][edge.Source]; ok && !propertiesAreEqual(properties, reverse.Properties) {
+				properties = combine(properties, reverse.Properties)
+			}
+		}
+
+		if err := simplified.AddEdge(edge.Source, edge.Target, copyEdgeProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return simplified, nil
+}
+
+// MinWeight combines the properties of two parallel edges by keeping the properties of the edge
+// with the smaller weight. If both edges have the same weight, the properties of a are kept. It
+// can be passed to Merge and Simplify, which then keep the cheapest edge between two vertices.
+func MinWeight(a, b EdgeProperties) EdgeProperties {
+	if b.Weight < a.Weight {
+		return b
+	}
+
+	return a
+}
+
+// SumWeights combines the properties of two parallel edges by adding up their weights, which is
+// useful for flow-like models where parallel edges represent additional capacity. The attributes
+// of a are kept. It can be passed to Merge and Simplify.
+func SumWeights(a, b EdgeProperties) EdgeProperties {
+	return EdgeProperties{
+		Attributes: a.Attributes,
+		Weight:     a.Weight + b.Weight,
+	}
+}
+
+func propertiesAreEqual(a, b EdgeProperties) bool {
+	if a.Weight != b.Weight || len(a.Attributes) != len(b.Attributes) {
+		return false
+	}
+
+	for key, aValue := range a.Attributes {
+		if bValue, ok := b.Attributes[key]; !ok || aValue != bValue {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PartitionEdges splits the graph into two new graphs by the given predicate: The matched graph
+// contains all edges for which keep returns true, and the unmatched graph contains all other edges.
+// Both graphs contain all vertices of the original graph and have the same traits, and the edges
+// keep their weights and attributes. The original graph remains unchanged.
+//
+// The predicate is called exactly once for each edge, which makes PartitionEdges more efficient
+// than filtering the graph twice with complementary predicates. For an undirected graph, each edge
+// is passed to the predicate only once, namely with the smaller hash as source.
+//
+// Like in DeepClone, additional functional options for the edge properties can be passed, which are
+// applied to each edge of both graphs after its weight and attributes have been copied.
+func PartitionEdges[K comparable, T any](g Graph[K, T], keep func(Edge[K]) bool, options ...func(*EdgeProperties)) (Graph[K, T], Graph[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	matched, err := newLike(g)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create graph: %w", err)
+	}
+
+	unmatched, err := newLike(g)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create graph: %w", err)
+	}
+
+	for _, vertex := range sortedMapKeys(adjacencyMap) {
+		if err := addVertexFrom(matched, g, vertex); err != nil {
+			return nil, nil, err
+		}
+		if err := addVertexFrom(unmatched, g, vertex); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, edge := range sortedEdges(adjacencyMap, g.Traits().IsDirected) {
+		partition := unmatched
+		if keep(edge) {
+			partition = matched
+		}
+
+		if err := partition.AddEdge(edge.Source, edge.Target, copiedEdgeOptions(edge.Properties, options)...); err != nil {
+			return nil, nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return matched, unmatched, nil
+}
+
+// LineGraph creates the line graph of the given graph. Each edge of the original graph becomes a
+// vertex of the line graph, identified by the hashes of its source and target vertex. In a directed
+// graph, the line graph contains an edge from (u, v) to (v, w) for every pair of consecutive edges,
+// so it is directed as well. In an undirected graph, two vertices of the line graph are joined if
+// the corresponding edges share an endpoint, and each undirected edge is represented only once with
+// the smaller hash first.
+//
+// The line graph doesn't contain self-loops, even if the original graph does. Edge weights and
+// attributes of the original graph are not carried over.
+func LineGraph[K comparable, T any](g Graph[K, T]) (Graph[[2]K, [2]K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	isDirected := g.Traits().IsDirected
+
+	hash := func(edge [2]K) [2]K {
+		return edge
+	}
+
+	var lineGraph Graph[[2]K, [2]K]
+	if isDirected {
+		lineGraph = New(hash, Directed())
+	} else {
+		lineGraph = New(hash)
+	}
+
+	edges := sortedEdges(adjacencyMap, isDirected)
+
+	for _, edge := range edges {
+		if err := lineGraph.AddVertex([2]K{edge.Source, edge.Target}); err != nil {
+			return nil, fmt.Errorf("failed to add vertex for edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	addEdge := func(a, b [2]K) error {
+		if a == b || lineGraph.HasEdge(a, b) {
+			return nil
+		}
+		if err := lineGraph.AddEdge(a, b); err != nil {
+			return fmt.Errorf("failed to add edge (%v, %v): %w", a, b, err)
+		}
+		return nil
+	}
+
+	if isDirected {
+		for _, edge := range edges {
+			for _, next := range sortedMapKeys(adjacencyMap[edge.Target]) {
+				if err := addEdge([2]K{edge.Source, edge.Target}, [2]K{edge.Target, next}); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		return lineGraph, nil
+	}
+
+	edgesByVertex := make(map[K][][2]K)
+
+	for _, edge := range edges {
+		vertex := [2]K{edge.Source, edge.Target}
+		edgesByVertex[edge.Source] = append(edgesByVertex[edge.Source], vertex)
+		if edge.Source != edge.Target {
+			edgesByVertex[edge.Target] = append(edgesByVertex[edge.Target], vertex)
+		}
+	}
+
+	for _, vertex := range sortedMapKeys(edgesByVertex) {
+		incident := edgesByVertex[vertex]
+
+		for i := 0; i < len(incident); i++ {
+			for j := i + 1; j < len(incident); j++ {
+				if err := addEdge(incident[i], incident[j]); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return lineGraph, nil
+}
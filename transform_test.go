@@ -0,0 +1,833 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+type taggedVertex struct {
+	ID   int
+	Tags []string
+}
+
+func TestDeepClone(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		copyValue  func(*taggedVertex) *taggedVertex
+		shouldFail bool
+	}{
+		"directed graph": {
+			isDirected: true,
+			copyValue: func(v *taggedVertex) *taggedVertex {
+				tags := make([]string, len(v.Tags))
+				copy(tags, v.Tags)
+				return &taggedVertex{ID: v.ID, Tags: tags}
+			},
+		},
+		"undirected graph": {
+			copyValue: func(v *taggedVertex) *taggedVertex {
+				tags := make([]string, len(v.Tags))
+				copy(tags, v.Tags)
+				return &taggedVertex{ID: v.ID, Tags: tags}
+			},
+		},
+		"copy with different hash": {
+			copyValue: func(v *taggedVertex) *taggedVertex {
+				return &taggedVertex{ID: v.ID + 100}
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		hash := func(v *taggedVertex) int {
+			return v.ID
+		}
+
+		var graph Graph[int, *taggedVertex]
+		if test.isDirected {
+			graph = New(hash, Directed(), Weighted())
+		} else {
+			graph = New(hash, Weighted())
+		}
+
+		_ = graph.AddVertex(&taggedVertex{ID: 1, Tags: []string{"a"}})
+		_ = graph.AddVertex(&taggedVertex{ID: 2, Tags: []string{"b"}})
+		_ = graph.AddVertex(&taggedVertex{ID: 3})
+
+		_ = graph.AddEdge(1, 2, EdgeWeight(5), EdgeAttribute("color", "red"))
+		_ = graph.AddEdge(2, 3, EdgeWeight(7))
+
+		clone, err := DeepClone(graph, test.copyValue)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if clone.Order() != graph.Order() || clone.Size() != graph.Size() {
+			t.Errorf("%s: order and size expectancy doesn't match: expected %v/%v, got %v/%v", name, graph.Order(), graph.Size(), clone.Order(), clone.Size())
+		}
+
+		if clone.Traits().IsDirected != test.isDirected {
+			t.Errorf("%s: directedness expectancy doesn't match: expected %v, got %v", name, test.isDirected, clone.Traits().IsDirected)
+		}
+
+		edge, err := clone.Edge(1, 2)
+		if err != nil {
+			t.Fatalf("%s: failed to get cloned edge: %s", name, err.Error())
+		}
+
+		if edge.Properties.Weight != 5 || edge.Properties.Attributes["color"] != "red" {
+			t.Errorf("%s: edge properties expectancy doesn't match: got %v", name, edge.Properties)
+		}
+
+		clonedVertex, _ := clone.Vertex(1)
+		clonedVertex.Tags[0] = "changed"
+		edge.Properties.Attributes["color"] = "blue"
+
+		originalVertex, _ := graph.Vertex(1)
+		if originalVertex.Tags[0] != "a" {
+			t.Errorf("%s: modifying the cloned vertex changed the original vertex", name)
+		}
+
+		originalEdge, _ := graph.Edge(1, 2)
+		if originalEdge.Properties.Attributes["color"] != "red" {
+			t.Errorf("%s: modifying the cloned edge changed the original edge", name)
+		}
+	}
+}
+
+func TestDeepClone_EdgeOptions(t *testing.T) {
+	for _, isDirected := range []bool{true, false} {
+		options := []func(*Traits){Weighted()}
+		if isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+		_ = graph.AddVertex(1)
+		_ = graph.AddVertex(2)
+		_ = graph.AddVertex(3)
+		_ = graph.AddEdge(1, 2, EdgeWeight(5), EdgeAttribute("color", "red"), EdgeAttribute("source", "local"))
+		_ = graph.AddEdge(2, 3, EdgeWeight(7))
+
+		clone, err := DeepClone(graph, func(v int) int { return v }, EdgeAttribute("source", "import"))
+		if err != nil {
+			t.Fatalf("directed %v: unexpected error: %s", isDirected, err.Error())
+		}
+
+		expected := map[[2]int]EdgeProperties{
+			{1, 2}: {Weight: 5, Attributes: map[string]string{"color": "red", "source": "import"}},
+			{2, 3}: {Weight: 7, Attributes: map[string]string{"source": "import"}},
+		}
+
+		for pair, properties := range expected {
+			edge, err := clone.Edge(pair[0], pair[1])
+			if err != nil {
+				t.Fatalf("directed %v: failed to get cloned edge: %s", isDirected, err.Error())
+			}
+
+			if !propertiesAreEqual(edge.Properties, properties) {
+				t.Errorf("directed %v: edge (%v, %v) properties expectancy doesn't match: expected %v, got %v", isDirected, pair[0], pair[1], properties, edge.Properties)
+			}
+		}
+
+		original, _ := graph.Edge(1, 2)
+		if original.Properties.Attributes["source"] != "local" {
+			t.Errorf("directed %v: attributes of the original graph have been modified: %v", isDirected, original.Properties.Attributes)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		srcIsDirected    bool
+		onVertexConflict func(existing, incoming string) string
+		onEdgeConflict   func(existing, incoming EdgeProperties) EdgeProperties
+		expectedVertices map[string]string
+		expectedWeights  map[string]map[string]int
+		shouldFail       bool
+	}{
+		"undirected graphs with conflict callbacks": {
+			onVertexConflict: func(existing, incoming string) string {
+				return existing + "+" + incoming
+			},
+			onEdgeConflict: func(existing, incoming EdgeProperties) EdgeProperties {
+				return EdgeProperties{Weight: existing.Weight + incoming.Weight}
+			},
+			expectedVertices: map[string]string{"A": "A:dst+A:src", "B": "B:dst+B:src", "C": "C:src"},
+			expectedWeights: map[string]map[string]int{
+				"A": {"B": 3},
+				"B": {"C": 4},
+			},
+		},
+		"directed graphs without callbacks": {
+			isDirected:       true,
+			srcIsDirected:    true,
+			expectedVertices: map[string]string{"A": "A:src", "B": "B:src", "C": "C:src"},
+			expectedWeights: map[string]map[string]int{
+				"A": {"B": 1},
+				"B": {"C": 4},
+			},
+		},
+		"directed graphs keeping the minimum weight": {
+			isDirected:       true,
+			srcIsDirected:    true,
+			onEdgeConflict:   MinWeight,
+			expectedVertices: map[string]string{"A": "A:src", "B": "B:src", "C": "C:src"},
+			expectedWeights: map[string]map[string]int{
+				"A": {"B": 1},
+				"B": {"C": 4},
+			},
+		},
+		"undirected graphs summing up weights": {
+			onEdgeConflict:   SumWeights,
+			expectedVertices: map[string]string{"A": "A:src", "B": "B:src", "C": "C:src"},
+			expectedWeights: map[string]map[string]int{
+				"A": {"B": 3},
+				"B": {"C": 4},
+			},
+		},
+		"graphs with different directedness": {
+			isDirected: true,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		hash := func(value string) string {
+			return value[:1]
+		}
+
+		newGraph := func(isDirected bool) Graph[string, string] {
+			if isDirected {
+				return New(hash, Directed(), Weighted())
+			}
+			return New(hash, Weighted())
+		}
+
+		dst := newGraph(test.isDirected)
+		_ = dst.AddVertex("A:dst")
+		_ = dst.AddVertex("B:dst")
+		_ = dst.AddEdge("A", "B", EdgeWeight(1))
+
+		src := newGraph(test.srcIsDirected)
+		_ = src.AddVertex("A:src")
+		_ = src.AddVertex("B:src")
+		_ = src.AddVertex("C:src")
+		_ = src.AddEdge("A", "B", EdgeWeight(2))
+		_ = src.AddEdge("B", "C", EdgeWeight(4))
+
+		err := Merge(dst, src, test.onVertexConflict, test.onEdgeConflict)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		for hash, expectedValue := range test.expectedVertices {
+			value, err := dst.Vertex(hash)
+			if err != nil || value != expectedValue {
+				t.Errorf("%s: vertex expectancy for %v doesn't match: expected %v, got %v", name, hash, expectedValue, value)
+			}
+		}
+
+		if dst.Size() != 2 {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, 2, dst.Size())
+		}
+
+		for source, targets := range test.expectedWeights {
+			for target, expectedWeight := range targets {
+				edge, err := dst.Edge(source, target)
+				if err != nil {
+					t.Errorf("%s: expected edge (%v, %v) doesn't exist", name, source, target)
+					continue
+				}
+				if edge.Properties.Weight != expectedWeight {
+					t.Errorf("%s: weight expectancy of (%v, %v) doesn't match: expected %v, got %v", name, source, target, expectedWeight, edge.Properties.Weight)
+				}
+			}
+		}
+	}
+}
+
+func TestMerge_Incompatible(t *testing.T) {
+	tests := map[string]struct {
+		dst              func() Graph[int, int]
+		src              func() Graph[int, int]
+		onVertexConflict func(existing, incoming int) int
+		expectedErr      error
+	}{
+		"graphs with different weightedness": {
+			dst: func() Graph[int, int] {
+				return New(IntHash, Directed(), Weighted())
+			},
+			src: func() Graph[int, int] {
+				return New(IntHash, Directed())
+			},
+		},
+		"acyclic graph and graph closing a cycle": {
+			dst: func() Graph[int, int] {
+				g := New(IntHash, Directed(), Acyclic())
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				_ = g.AddEdge(1, 2)
+				return g
+			},
+			src: func() Graph[int, int] {
+				g := New(IntHash, Directed())
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				_ = g.AddVertex(3)
+				_ = g.AddEdge(2, 3)
+				_ = g.AddEdge(3, 1)
+				return g
+			},
+			expectedErr: ErrEdgeCreatesCycle,
+		},
+		"acyclic graph and graph with self-loop": {
+			dst: func() Graph[int, int] {
+				g := New(IntHash, Acyclic())
+				_ = g.AddVertex(1)
+				return g
+			},
+			src: func() Graph[int, int] {
+				g := New(IntHash)
+				_ = g.AddVertex(1)
+				_ = g.AddEdge(1, 1)
+				return g
+			},
+			expectedErr: ErrEdgeCreatesCycle,
+		},
+		"vertex conflict resolved to a different hash": {
+			dst: func() Graph[int, int] {
+				g := New(IntHash)
+				_ = g.AddVertex(1)
+				return g
+			},
+			src: func() Graph[int, int] {
+				g := New(IntHash)
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				_ = g.AddEdge(1, 2)
+				return g
+			},
+			onVertexConflict: func(existing, incoming int) int {
+				return existing + incoming
+			},
+		},
+	}
+
+	for name, test := range tests {
+		dst := test.dst()
+		order, size := dst.Order(), dst.Size()
+
+		err := Merge(dst, test.src(), test.onVertexConflict, nil)
+		if err == nil {
+			t.Fatalf("%s: error expectancy doesn't match: expected error, got nil", name)
+		}
+
+		if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+			t.Errorf("%s: error expectancy doesn't match: expected %v, got %v", name, test.expectedErr, err)
+		}
+
+		if dst.Order() != order || dst.Size() != size {
+			t.Errorf("%s: destination graph has been modified: expected order %v and size %v, got %v and %v", name, order, size, dst.Order(), dst.Size())
+		}
+	}
+}
+
+// customGraph is a Graph implementation that is unknown to the package, so that its hashing
+// function isn't accessible.
+type customGraph struct {
+	Graph[int, int]
+}
+
+func TestMerge_CustomGraph(t *testing.T) {
+	dst := &customGraph{Graph: New(IntHash, Directed())}
+	_ = dst.AddVertex(1)
+
+	src := New(IntHash, Directed())
+	_ = src.AddVertex(1)
+	_ = src.AddVertex(2)
+	_ = src.AddEdge(1, 2)
+
+	if err := Merge[int, int](dst, src, nil, nil); err != nil {
+		t.Fatalf("failed to merge graphs: %s", err.Error())
+	}
+
+	if dst.Order() != 2 {
+		t.Errorf("order expectancy doesn't match: expected %v, got %v", 2, dst.Order())
+	}
+
+	if !dst.HasEdge(1, 2) {
+		t.Errorf("expected merged graph to contain edge (%v, %v)", 1, 2)
+	}
+}
+
+func TestMinWeight(t *testing.T) {
+	tests := map[string]struct {
+		a        EdgeProperties
+		b        EdgeProperties
+		expected EdgeProperties
+	}{
+		"first edge is lighter": {
+			a:        EdgeProperties{Weight: 1, Attributes: map[string]string{"name": "a"}},
+			b:        EdgeProperties{Weight: 2, Attributes: map[string]string{"name": "b"}},
+			expected: EdgeProperties{Weight: 1, Attributes: map[string]string{"name": "a"}},
+		},
+		"second edge is lighter": {
+			a:        EdgeProperties{Weight: 5, Attributes: map[string]string{"name": "a"}},
+			b:        EdgeProperties{Weight: -2, Attributes: map[string]string{"name": "b"}},
+			expected: EdgeProperties{Weight: -2, Attributes: map[string]string{"name": "b"}},
+		},
+		"edges with equal weights": {
+			a:        EdgeProperties{Weight: 3, Attributes: map[string]string{"name": "a"}},
+			b:        EdgeProperties{Weight: 3, Attributes: map[string]string{"name": "b"}},
+			expected: EdgeProperties{Weight: 3, Attributes: map[string]string{"name": "a"}},
+		},
+	}
+
+	for name, test := range tests {
+		if properties := MinWeight(test.a, test.b); !propertiesAreEqual(properties, test.expected) {
+			t.Errorf("%s: properties expectancy doesn't match: expected %v, got %v", name, test.expected, properties)
+		}
+	}
+}
+
+func TestSumWeights(t *testing.T) {
+	tests := map[string]struct {
+		a        EdgeProperties
+		b        EdgeProperties
+		expected EdgeProperties
+	}{
+		"positive weights": {
+			a:        EdgeProperties{Weight: 1, Attributes: map[string]string{"name": "a"}},
+			b:        EdgeProperties{Weight: 2, Attributes: map[string]string{"name": "b"}},
+			expected: EdgeProperties{Weight: 3, Attributes: map[string]string{"name": "a"}},
+		},
+		"edges without attributes": {
+			a:        EdgeProperties{Weight: 4},
+			b:        EdgeProperties{Weight: -1},
+			expected: EdgeProperties{Weight: 3},
+		},
+	}
+
+	for name, test := range tests {
+		if properties := SumWeights(test.a, test.b); !propertiesAreEqual(properties, test.expected) {
+			t.Errorf("%s: properties expectancy doesn't match: expected %v, got %v", name, test.expected, properties)
+		}
+	}
+}
+
+// TestParallelEdges adds the same edge multiple times with different weights. Since graphs don't
+// store parallel edges, the duplicates are rejected by AddEdge and have to be collapsed using
+// Merge, so that shortest path searches see the minimum weight.
+func TestParallelEdges(t *testing.T) {
+	g := New(StringHash, Directed(), Weighted())
+	_ = g.AddVertex("A")
+	_ = g.AddVertex("B")
+	_ = g.AddVertex("C")
+	_ = g.AddEdge("A", "B", EdgeWeight(10))
+	_ = g.AddEdge("A", "C", EdgeWeight(3))
+	_ = g.AddEdge("C", "B", EdgeWeight(3))
+
+	if err := g.AddEdge("A", "B", EdgeWeight(1)); err == nil {
+		t.Fatal("expected adding a parallel edge to fail")
+	}
+
+	parallel := New(StringHash, Directed(), Weighted())
+	_ = parallel.AddVertex("A")
+	_ = parallel.AddVertex("B")
+	_ = parallel.AddEdge("A", "B", EdgeWeight(1))
+
+	if err := Merge(g, parallel, nil, MinWeight); err != nil {
+		t.Fatalf("failed to merge graphs: %s", err.Error())
+	}
+
+	path, err := ShortestPath(g, "A", "B")
+	if err != nil {
+		t.Fatalf("failed to compute shortest path: %s", err.Error())
+	}
+
+	if expected := []string{"A", "B"}; !orderedSlicesAreEqual(path, expected) {
+		t.Errorf("path expectancy doesn't match: expected %v, got %v", expected, path)
+	}
+
+	if edge, _ := g.Edge("A", "B"); edge.Properties.Weight != 1 {
+		t.Errorf("weight expectancy doesn't match: expected %v, got %v", 1, edge.Properties.Weight)
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	tests := map[string]struct {
+		graph           func() Graph[int, int]
+		expectedSize    int
+		expectedWeights map[int]map[int]int
+	}{
+		"directed graph with self-loop": {
+			graph: func() Graph[int, int] {
+				g := New(IntHash, Directed())
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				_ = g.AddEdge(1, 1)
+				_ = g.AddEdge(1, 2, EdgeWeight(3))
+				_ = g.AddEdge(2, 1, EdgeWeight(4))
+				return g
+			},
+			expectedSize: 2,
+			expectedWeights: map[int]map[int]int{
+				1: {2: 3},
+				2: {1: 4},
+			},
+		},
+		"undirected graph with asymmetric reverse edge": {
+			graph: func() Graph[int, int] {
+				g := newUndirected(IntHash, &Traits{})
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				_ = g.AddEdge(1, 1)
+				_ = g.AddEdge(1, 2, EdgeWeight(2))
+				// Manipulate the reverse direction of the edge so that it has another weight.
+				g.outEdges[2][1] = Edge[int]{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 5}}
+				return g
+			},
+			expectedSize: 1,
+			expectedWeights: map[int]map[int]int{
+				1: {2: 7},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		graph := test.graph()
+		originalSize := graph.Size()
+
+		simplified, err := Simplify(graph, func(a, b EdgeProperties) EdgeProperties {
+			return EdgeProperties{Weight: a.Weight + b.Weight}
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if graph.Size() != originalSize {
+			t.Errorf("%s: original graph has been modified", name)
+		}
+
+		if simplified.Size() != test.expectedSize {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, test.expectedSize, simplified.Size())
+		}
+
+		for source, targets := range test.expectedWeights {
+			for target, expectedWeight := range targets {
+				edge, err := simplified.Edge(source, target)
+				if err != nil {
+					t.Errorf("%s: expected edge (%v, %v) doesn't exist", name, source, target)
+					continue
+				}
+				if edge.Properties.Weight != expectedWeight {
+					t.Errorf("%s: weight expectancy of (%v, %v) doesn't match: expected %v, got %v", name, source, target, expectedWeight, edge.Properties.Weight)
+				}
+			}
+		}
+	}
+}
+
+func TestPartitionEdges(t *testing.T) {
+	tests := map[string]struct {
+		isDirected         bool
+		vertices           []int
+		edges              []Edge[int]
+		keep               func(Edge[int]) bool
+		expectedMatched    []Edge[int]
+		expectedUnmatched  []Edge[int]
+		expectedPredicates int
+	}{
+		"directed graph by weight": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5, Attributes: map[string]string{"color": "red"}}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 7}},
+				{Source: 3, Target: 3, Properties: EdgeProperties{Weight: 2}},
+			},
+			keep: func(edge Edge[int]) bool {
+				return edge.Properties.Weight > 3
+			},
+			expectedMatched: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5, Attributes: map[string]string{"color": "red"}}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 7}},
+			},
+			expectedUnmatched: []Edge[int]{
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 3, Properties: EdgeProperties{Weight: 2}},
+			},
+			expectedPredicates: 4,
+		},
+		"undirected graph by attribute": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 2, Target: 1, Properties: EdgeProperties{Attributes: map[string]string{"status": "added"}}},
+				{Source: 3, Target: 2, Properties: EdgeProperties{Attributes: map[string]string{"status": "removed"}}},
+			},
+			keep: func(edge Edge[int]) bool {
+				return edge.Properties.Attributes["status"] == "added"
+			},
+			expectedMatched: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Attributes: map[string]string{"status": "added"}}},
+			},
+			expectedUnmatched: []Edge[int]{
+				{Source: 2, Target: 3, Properties: EdgeProperties{Attributes: map[string]string{"status": "removed"}}},
+			},
+			expectedPredicates: 2,
+		},
+		"graph without edges": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			keep: func(edge Edge[int]) bool {
+				return true
+			},
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			edgeOptions := []func(*EdgeProperties){EdgeWeight(edge.Properties.Weight)}
+			for key, value := range edge.Properties.Attributes {
+				edgeOptions = append(edgeOptions, EdgeAttribute(key, value))
+			}
+
+			if err := graph.AddEdge(edge.Source, edge.Target, edgeOptions...); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		predicates := 0
+		keep := func(edge Edge[int]) bool {
+			predicates++
+			return test.keep(edge)
+		}
+
+		matched, unmatched, err := PartitionEdges(graph, keep)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if predicates != test.expectedPredicates {
+			t.Errorf("%s: predicate call count doesn't match: expected %v, got %v", name, test.expectedPredicates, predicates)
+		}
+
+		for partitionName, partition := range map[string]struct {
+			graph Graph[int, int]
+			edges []Edge[int]
+		}{
+			"matched":   {graph: matched, edges: test.expectedMatched},
+			"unmatched": {graph: unmatched, edges: test.expectedUnmatched},
+		} {
+			if partition.graph.Order() != len(test.vertices) {
+				t.Errorf("%s: order of %s graph doesn't match: expected %v, got %v", name, partitionName, len(test.vertices), partition.graph.Order())
+			}
+
+			if partition.graph.Size() != len(partition.edges) {
+				t.Errorf("%s: size of %s graph doesn't match: expected %v, got %v", name, partitionName, len(partition.edges), partition.graph.Size())
+			}
+
+			if partition.graph.Traits().IsDirected != test.isDirected {
+				t.Errorf("%s: %s graph has different traits", name, partitionName)
+			}
+
+			for _, expectedEdge := range partition.edges {
+				edge, err := partition.graph.Edge(expectedEdge.Source, expectedEdge.Target)
+				if err != nil {
+					t.Fatalf("%s: edge (%v, %v) expected in %s graph", name, expectedEdge.Source, expectedEdge.Target, partitionName)
+				}
+
+				if !propertiesAreEqual(edge.Properties, expectedEdge.Properties) {
+					t.Errorf("%s: properties of edge (%v, %v) in %s graph don't match: expected %v, got %v", name, expectedEdge.Source, expectedEdge.Target, partitionName, expectedEdge.Properties, edge.Properties)
+				}
+			}
+		}
+	}
+}
+
+func TestPartitionEdges_EdgeOptions(t *testing.T) {
+	graph := New(IntHash, Directed(), Weighted())
+	_ = graph.AddVertex(1)
+	_ = graph.AddVertex(2)
+	_ = graph.AddVertex(3)
+	_ = graph.AddEdge(1, 2, EdgeWeight(5), EdgeAttribute("color", "red"))
+	_ = graph.AddEdge(2, 3, EdgeWeight(7))
+
+	keep := func(edge Edge[int]) bool {
+		return edge.Source == 1
+	}
+
+	matched, unmatched, err := PartitionEdges(graph, keep, EdgeAttribute("partition", "any"), EdgeWeight(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	tests := map[string]struct {
+		graph    Graph[int, int]
+		source   int
+		target   int
+		expected EdgeProperties
+	}{
+		"matched edge": {
+			graph:    matched,
+			source:   1,
+			target:   2,
+			expected: EdgeProperties{Weight: 1, Attributes: map[string]string{"color": "red", "partition": "any"}},
+		},
+		"unmatched edge": {
+			graph:    unmatched,
+			source:   2,
+			target:   3,
+			expected: EdgeProperties{Weight: 1, Attributes: map[string]string{"partition": "any"}},
+		},
+	}
+
+	for name, test := range tests {
+		edge, err := test.graph.Edge(test.source, test.target)
+		if err != nil {
+			t.Fatalf("%s: failed to get edge: %s", name, err.Error())
+		}
+
+		if !propertiesAreEqual(edge.Properties, test.expected) {
+			t.Errorf("%s: properties expectancy doesn't match: expected %v, got %v", name, test.expected, edge.Properties)
+		}
+	}
+}
+
+func TestLineGraph(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		edges            []Edge[int]
+		expectedVertices [][2]int
+		expectedEdges    []Edge[[2]int]
+	}{
+		"directed path": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			expectedVertices: [][2]int{{1, 2}, {2, 3}, {3, 4}},
+			expectedEdges: []Edge[[2]int]{
+				{Source: [2]int{1, 2}, Target: [2]int{2, 3}},
+				{Source: [2]int{2, 3}, Target: [2]int{3, 4}},
+			},
+		},
+		"directed cycle": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			expectedVertices: [][2]int{{1, 2}, {2, 1}},
+			expectedEdges: []Edge[[2]int]{
+				{Source: [2]int{1, 2}, Target: [2]int{2, 1}},
+				{Source: [2]int{2, 1}, Target: [2]int{1, 2}},
+			},
+		},
+		"undirected star": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 1},
+				{Source: 1, Target: 4},
+			},
+			expectedVertices: [][2]int{{1, 2}, {1, 3}, {1, 4}},
+			expectedEdges: []Edge[[2]int]{
+				{Source: [2]int{1, 2}, Target: [2]int{1, 3}},
+				{Source: [2]int{1, 2}, Target: [2]int{1, 4}},
+				{Source: [2]int{1, 3}, Target: [2]int{1, 4}},
+			},
+		},
+		"undirected triangle": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedVertices: [][2]int{{1, 2}, {1, 3}, {2, 3}},
+			expectedEdges: []Edge[[2]int]{
+				{Source: [2]int{1, 2}, Target: [2]int{1, 3}},
+				{Source: [2]int{1, 2}, Target: [2]int{2, 3}},
+				{Source: [2]int{1, 3}, Target: [2]int{2, 3}},
+			},
+		},
+		"graph without edges": {
+			expectedVertices: [][2]int{},
+			expectedEdges:    []Edge[[2]int]{},
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for i := 1; i <= 4; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		lineGraph, err := LineGraph(graph)
+		if err != nil {
+			t.Fatalf("%s: failed to create line graph: %s", name, err.Error())
+		}
+
+		if lineGraph.Traits().IsDirected != test.isDirected {
+			t.Errorf("%s: directedness expectancy doesn't match: expected %v, got %v", name, test.isDirected, lineGraph.Traits().IsDirected)
+		}
+
+		adjacencyMap, _ := lineGraph.AdjacencyMap()
+		vertices := make([][2]int, 0)
+		for vertex := range adjacencyMap {
+			vertices = append(vertices, vertex)
+		}
+
+		if !slicesAreEqual(vertices, test.expectedVertices) {
+			t.Errorf("%s: vertex expectancy doesn't match: expected %v, got %v", name, test.expectedVertices, vertices)
+		}
+
+		if lineGraph.Size() != len(test.expectedEdges) {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), lineGraph.Size())
+		}
+
+		for _, edge := range test.expectedEdges {
+			if !lineGraph.HasEdge(edge.Source, edge.Target) {
+				t.Errorf("%s: expected edge (%v, %v) doesn't exist", name, edge.Source, edge.Target)
+			}
+		}
+	}
+}
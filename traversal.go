@@ -1,6 +1,9 @@
 package graph
 
-import "fmt"
+import (
+	"fmt"
+	"math/rand"
+)
 
 // DFS performs a depth-first search on the graph, starting from the given vertex. The visit
 // function will be invoked with the hash of the vertex currently visited. If it returns false, DFS
@@ -135,3 +138,339 @@ func BFS[K comparable, T any](g Graph[K, T], start K, visit func(K) bool) error
 
 	return nil
 }
+
+// RandomWalk performs a random walk of the given length on the graph, starting from the given
+// vertex, and returns the hashes of the visited vertices. In each step, the walk moves to one of
+// the adjacent vertices of the current vertex, each of which is chosen with the same probability.
+// All random decisions are made using the given random number generator, so a seeded generator
+// makes the walk reproducible.
+//
+// The returned walk includes the start vertex, so it consists of up to length + 1 vertices. If the
+// walk hits a dead end, i.e. a vertex without any adjacent vertices, it stops early and the walk up
+// to that vertex is returned. Therefore, the returned walk may be shorter than requested.
+func RandomWalk[K comparable, T any](g Graph[K, T], start K, length int, rng *rand.Rand) ([]K, error) {
+	return randomWalk(g, start, length, rng, false)
+}
+
+// WeightedRandomWalk works like RandomWalk, but biases the transitions by the edge weights: The
+// probability of moving to an adjacent vertex is proportional to the weight of the edge joining
+// the current vertex and that adjacent vertex. Edges with a weight of 0 or less are never taken,
+// so a vertex whose edges all have such a weight is treated as a dead end.
+//
+// If the graph doesn't have the Weighted trait, its edges don't carry meaningful weights. In that
+// case, each adjacent vertex is chosen with the same probability, just like in RandomWalk.
+func WeightedRandomWalk[K comparable, T any](g Graph[K, T], start K, length int, rng *rand.Rand) ([]K, error) {
+	return randomWalk(g, start, length, rng, g.Traits().IsWeighted)
+}
+
+func randomWalk[K comparable, T any](g Graph[K, T], start K, length int, rng *rand.Rand, weighted bool) ([]K, error) {
+	if length < 0 {
+		return nil, fmt.Errorf("walk length must not be negative, got %d", length)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[start]; !ok {
+		return nil, fmt.Errorf("could not find start vertex with hash %v", start)
+	}
+
+	walk := []K{start}
+	currentHash := start
+
+	for step := 0; step < length; step++ {
+		// The adjacencies are sorted so that the same random number generator state always
+		// yields the same walk, regardless of the map iteration order.
+		adjacencies := make([]K, 0, len(adjacencyMap[currentHash]))
+		totalWeight := 0
+
+		for adjacency, edge := range adjacencyMap[currentHash] {
+			if weighted && edge.Properties.Weight <= 0 {
+				continue
+			}
+			adjacencies = append(adjacencies, adjacency)
+			totalWeight += edge.Properties.Weight
+		}
+
+		if len(adjacencies) == 0 {
+			break
+		}
+
+		sortKeys(adjacencies)
+
+		var next K
+
+		if weighted {
+			threshold := rng.Intn(totalWeight)
+
+			for _, adjacency := range adjacencies {
+				threshold -= adjacencyMap[currentHash][adjacency].Properties.Weight
+				if threshold < 0 {
+					next = adjacency
+					break
+				}
+			}
+		} else {
+			next = adjacencies[rng.Intn(len(adjacencies))]
+		}
+
+		walk = append(walk, next)
+		currentHash = next
+	}
+
+	return walk, nil
+}
+
+// BFSTree computes the breadth-first search tree of the graph rooted at the given source vertex
+// and returns it as a new graph. The tree contains the source vertex and all vertices reachable
+// from it, joined by the edges via which the BFS discovered them. Vertices that are not reachable
+// from the source are not contained in the tree.
+//
+// Since a BFS discovers each vertex via a path with the fewest possible edges, the path from the
+// source to any vertex in the tree is a shortest path in terms of hops. The tree edges keep their
+// weights and attributes, and the tree has the same traits as the original graph. The vertices are
+// visited in ascending order of their hashes, so the tree is deterministic.
+func BFSTree[K comparable, T any](g Graph[K, T], source K) (Graph[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	tree, err := newLike(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tree: %w", err)
+	}
+
+	if err := addVertexFrom(tree, g, source); err != nil {
+		return nil, err
+	}
+
+	visited := map[K]bool{source: true}
+	queue := []K{source}
+
+	for len(queue) > 0 {
+		currentHash := queue[0]
+		queue = queue[1:]
+
+		for _, adjacency := range sortedMapKeys(adjacencyMap[currentHash]) {
+			if visited[adjacency] {
+				continue
+			}
+			visited[adjacency] = true
+			queue = append(queue, adjacency)
+
+			edge := adjacencyMap[currentHash][adjacency]
+
+			if err := addVertexFrom(tree, g, adjacency); err != nil {
+				return nil, err
+			}
+			if err := tree.AddEdge(currentHash, adjacency, copyEdgeProperties(edge.Properties)); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", currentHash, adjacency, err)
+			}
+		}
+	}
+
+	return tree, nil
+}
+
+// BFSParents performs a breadth-first search starting from the given source vertex and returns the
+// parent of each reachable vertex, i.e. the vertex from which the BFS discovered it. The source has
+// no parent and is not contained in the returned map, and neither are unreachable vertices.
+//
+// The parents form the same tree as BFSTree, but without creating a new graph. Following the
+// parents from any vertex back to the source yields a shortest path in terms of hops. The vertices
+// are visited in ascending order of their hashes, so the parents are deterministic.
+func BFSParents[K comparable, T any](g Graph[K, T], source K) (map[K]K, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	parents := make(map[K]K)
+	queue := []K{source}
+
+	for len(queue) > 0 {
+		currentHash := queue[0]
+		queue = queue[1:]
+
+		for _, adjacency := range sortedMapKeys(adjacencyMap[currentHash]) {
+			if _, ok := parents[adjacency]; ok || adjacency == source {
+				continue
+			}
+			parents[adjacency] = currentHash
+			queue = append(queue, adjacency)
+		}
+	}
+
+	return parents, nil
+}
+
+// ReverseReachable returns the hashes of all vertices that can reach the given vertex, i.e. all
+// vertices that have a path to it. In a dependency graph where edges point from a dependent to its
+// dependency, these are the vertices that directly or transitively depend on the given vertex.
+// The vertex itself is not contained in the result, even if it lies on a cycle.
+//
+// ReverseReachable walks the predecessors of the vertex using the graph's predecessor map, so it
+// doesn't need to build the transpose of the graph. In an undirected graph, the result contains
+// all other vertices of the vertex's connected component.
+func ReverseReachable[K comparable, T any](g Graph[K, T], vertex K) (map[K]struct{}, error) {
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	if _, ok := predecessorMap[vertex]; !ok {
+		return nil, fmt.Errorf("could not find vertex with hash %v", vertex)
+	}
+
+	reachable := make(map[K]struct{})
+	stack := []K{vertex}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for predecessor := range predecessorMap[current] {
+			if _, ok := reachable[predecessor]; ok || predecessor == vertex {
+				continue
+			}
+			reachable[predecessor] = struct{}{}
+			stack = append(stack, predecessor)
+		}
+	}
+
+	return reachable, nil
+}
+
+// ReachableWithin returns all vertices that can be reached from the source vertex using at most
+// maxHops edges, along with their hop distance from the source. The source vertex itself is
+// contained in the result with a distance of 0, so a maxHops value of 0 yields only the source.
+//
+// The hop distance is the number of edges on a shortest path from the source, regardless of edge
+// weights. For example, the friends of a user within two degrees can be found like so:
+//
+//	friends, _ := graph.ReachableWithin(g, "alice", 2)
+func ReachableWithin[K comparable, T any](g Graph[K, T], source K, maxHops int) (map[K]int, error) {
+	if maxHops < 0 {
+		return nil, fmt.Errorf("maximum number of hops must not be negative, got %d", maxHops)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	distances := map[K]int{source: 0}
+	level := []K{source}
+
+	for hops := 1; hops <= maxHops && len(level) > 0; hops++ {
+		var next []K
+
+		for _, vertex := range level {
+			for adjacency := range adjacencyMap[vertex] {
+				if _, ok := distances[adjacency]; ok {
+					continue
+				}
+				distances[adjacency] = hops
+				next = append(next, adjacency)
+			}
+		}
+
+		level = next
+	}
+
+	return distances, nil
+}
+
+// UnreachablePairs returns all ordered pairs of vertices (u, v) where v cannot be reached from u,
+// i.e. where there is no path from u to v. Each vertex is considered reachable from itself, so
+// pairs of a vertex with itself are never returned. The pairs are sorted in ascending order of u
+// and v. In an undirected graph, the result contains both (u, v) and (v, u) for all vertices u and
+// v in different connected components.
+//
+// The number of pairs grows quadratically with the number of vertices. Use VisitUnreachablePairs
+// to process the pairs one by one without collecting them.
+func UnreachablePairs[K comparable, T any](g Graph[K, T]) ([][2]K, error) {
+	pairs := make([][2]K, 0)
+
+	err := VisitUnreachablePairs(g, func(source, target K) bool {
+		pairs = append(pairs, [2]K{source, target})
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
+// VisitUnreachablePairs determines all ordered pairs of vertices (u, v) where v cannot be reached
+// from u like UnreachablePairs does, but calls the visit function for each pair instead of
+// collecting them. If the visit function returns true, the enumeration stops.
+//
+// The reachable vertices are determined by a separate search from each vertex, so only the
+// vertices reachable from a single vertex are kept in memory at a time.
+func VisitUnreachablePairs[K comparable, T any](g Graph[K, T], visit func(source, target K) bool) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	vertices := sortedMapKeys(adjacencyMap)
+
+	for _, source := range vertices {
+		reachable := map[K]bool{source: true}
+		stack := []K{source}
+
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			for adjacency := range adjacencyMap[current] {
+				if !reachable[adjacency] {
+					reachable[adjacency] = true
+					stack = append(stack, adjacency)
+				}
+			}
+		}
+
+		for _, target := range vertices {
+			if reachable[target] {
+				continue
+			}
+			if visit(source, target) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// addVertexFrom adds the vertex with the given hash from the source graph to the target graph.
+func addVertexFrom[K comparable, T any](target, source Graph[K, T], hash K) error {
+	vertex, err := source.Vertex(hash)
+	if err != nil {
+		return fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+	}
+
+	if err := target.AddVertex(vertex); err != nil {
+		return fmt.Errorf("failed to add vertex with hash %v: %w", hash, err)
+	}
+
+	return nil
+}
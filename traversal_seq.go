@@ -0,0 +1,53 @@
+//go:build go1.23
+
+package graph
+
+import "iter"
+
+// BFSSeq returns an iterator over the vertex hashes of the graph in BFS order, starting from the
+// given vertex. It visits the vertices in the same order as BFS and is meant to be used with a
+// range-over-func loop:
+//
+//	for hash := range graph.BFSSeq(g, 1) {
+//		fmt.Println(hash)
+//	}
+//
+// The traversal is performed lazily and stops as soon as the loop is left. If the start vertex
+// doesn't exist or the adjacency map cannot be obtained, the iterator doesn't yield any values.
+// Use BFS instead if these errors need to be handled.
+func BFSSeq[K comparable, T any](g Graph[K, T], start K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		_ = BFS(g, start, func(hash K) bool {
+			return !yield(hash)
+		})
+	}
+}
+
+// DFSSeq returns an iterator over the vertex hashes of the graph in DFS order, starting from the
+// given vertex. It visits the vertices in the same order as DFS. See BFSSeq for details on using
+// the iterator and on how errors are handled.
+func DFSSeq[K comparable, T any](g Graph[K, T], start K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		_ = DFS(g, start, func(hash K) bool {
+			return !yield(hash)
+		})
+	}
+}
+
+// VerticesSeq returns an iterator over the hashes of all vertices in the graph. The vertices are
+// yielded in an unspecified order. If the adjacency map cannot be obtained, the iterator doesn't
+// yield any values.
+func VerticesSeq[K comparable, T any](g Graph[K, T]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		adjacencyMap, err := g.AdjacencyMap()
+		if err != nil {
+			return
+		}
+
+		for hash := range adjacencyMap {
+			if !yield(hash) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+//go:build go1.23
+
+package graph
+
+import "testing"
+
+func TestBFSSeq(t *testing.T) {
+	tests := map[string]struct {
+		vertices       []int
+		edges          []Edge[int]
+		start          int
+		stopAtVertex   int
+		expectedVisits []int
+	}{
+		"traverse entire graph": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+			},
+			start:          1,
+			stopAtVertex:   -1,
+			expectedVisits: []int{1, 2, 3, 4},
+		},
+		"stop at vertex 2": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+			},
+			start:          1,
+			stopAtVertex:   2,
+			expectedVisits: []int{1, 2},
+		},
+		"unknown start vertex": {
+			vertices:       []int{1},
+			start:          5,
+			stopAtVertex:   -1,
+			expectedVisits: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash, Directed())
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		visits := make([]int, 0)
+
+		for hash := range BFSSeq(graph, test.start) {
+			visits = append(visits, hash)
+			if hash == test.stopAtVertex {
+				break
+			}
+		}
+
+		if !slicesAreEqual(visits, test.expectedVisits) {
+			t.Errorf("%s: visits expectancy doesn't match: expected %v, got %v", name, test.expectedVisits, visits)
+		}
+	}
+}
+
+func TestDFSSeq(t *testing.T) {
+	graph := New(IntHash, Directed())
+
+	for _, vertex := range []int{1, 2, 3, 4} {
+		_ = graph.AddVertex(vertex)
+	}
+
+	_ = graph.AddEdge(1, 2)
+	_ = graph.AddEdge(2, 3)
+	_ = graph.AddEdge(3, 4)
+
+	visits := make([]int, 0)
+
+	for hash := range DFSSeq(graph, 1) {
+		visits = append(visits, hash)
+		if hash == 3 {
+			break
+		}
+	}
+
+	expectedVisits := []int{1, 2, 3}
+
+	for i, expectedVisit := range expectedVisits {
+		if i >= len(visits) || visits[i] != expectedVisit {
+			t.Fatalf("visits expectancy doesn't match: expected %v, got %v", expectedVisits, visits)
+		}
+	}
+}
+
+func TestVerticesSeq(t *testing.T) {
+	graph := New(IntHash)
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = graph.AddVertex(vertex)
+	}
+
+	vertices := make([]int, 0)
+
+	for hash := range VerticesSeq(graph) {
+		vertices = append(vertices, hash)
+	}
+
+	if !slicesAreEqual(vertices, []int{1, 2, 3}) {
+		t.Errorf("vertices expectancy doesn't match: expected %v, got %v", []int{1, 2, 3}, vertices)
+	}
+}
@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"math/rand"
 	"testing"
 )
 
@@ -397,3 +398,669 @@ func TestUndirectedBFS(t *testing.T) {
 		}
 	}
 }
+
+func TestRandomWalk(t *testing.T) {
+	tests := map[string]struct {
+		isDirected     bool
+		vertices       []int
+		edges          []Edge[int]
+		start          int
+		length         int
+		expectedLength int
+		shouldFail     bool
+	}{
+		"walk on undirected cycle": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			start:          1,
+			length:         10,
+			expectedLength: 11,
+		},
+		"walk stops at dead end": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			start:          1,
+			length:         10,
+			expectedLength: 3,
+		},
+		"walk of length 0": {
+			vertices:       []int{1},
+			start:          1,
+			length:         0,
+			expectedLength: 1,
+		},
+		"unknown start vertex": {
+			vertices:   []int{1},
+			start:      2,
+			length:     3,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		walk, err := RandomWalk(graph, test.start, test.length, rand.New(rand.NewSource(1)))
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(walk) != test.expectedLength {
+			t.Errorf("%s: walk length expectancy doesn't match: expected %v, got %v", name, test.expectedLength, len(walk))
+		}
+
+		if walk[0] != test.start {
+			t.Errorf("%s: walk doesn't begin with start vertex %v: %v", name, test.start, walk)
+		}
+
+		for i := 1; i < len(walk); i++ {
+			if _, err := graph.Edge(walk[i-1], walk[i]); err != nil {
+				t.Errorf("%s: walk contains non-existent edge (%v, %v)", name, walk[i-1], walk[i])
+			}
+		}
+
+		again, _ := RandomWalk(graph, test.start, test.length, rand.New(rand.NewSource(1)))
+		for i := range walk {
+			if walk[i] != again[i] {
+				t.Errorf("%s: walk isn't reproducible: %v != %v", name, walk, again)
+				break
+			}
+		}
+	}
+}
+
+func TestWeightedRandomWalk(t *testing.T) {
+	graph := New(IntHash, Directed(), Weighted())
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = graph.AddVertex(vertex)
+	}
+
+	_ = graph.AddEdge(1, 2, EdgeWeight(1))
+	_ = graph.AddEdge(1, 3, EdgeWeight(0))
+	_ = graph.AddEdge(2, 1, EdgeWeight(1))
+
+	walk, err := WeightedRandomWalk[int, int](graph, 1, 20, rand.New(rand.NewSource(42)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	for _, vertex := range walk {
+		if vertex == 3 {
+			t.Errorf("walk took an edge with weight 0: %v", walk)
+		}
+	}
+
+	if len(walk) != 21 {
+		t.Errorf("walk length expectancy doesn't match: expected %v, got %v", 21, len(walk))
+	}
+}
+
+func TestWeightedRandomWalk_Unweighted(t *testing.T) {
+	graph := New(IntHash, Directed())
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = graph.AddVertex(vertex)
+	}
+
+	_ = graph.AddEdge(1, 2)
+	_ = graph.AddEdge(1, 3)
+	_ = graph.AddEdge(2, 1)
+	_ = graph.AddEdge(3, 1)
+
+	walk, err := WeightedRandomWalk[int, int](graph, 1, 20, rand.New(rand.NewSource(42)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if len(walk) != 21 {
+		t.Errorf("walk length expectancy doesn't match: expected %v, got %v", 21, len(walk))
+	}
+
+	expected, _ := RandomWalk[int, int](graph, 1, 20, rand.New(rand.NewSource(42)))
+
+	if !orderedSlicesAreEqual(walk, expected) {
+		t.Errorf("walk expectancy doesn't match: expected %v, got %v", expected, walk)
+	}
+}
+
+func TestBFSTree(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		vertices         []int
+		edges            []Edge[int]
+		source           int
+		expectedVertices []int
+		expectedDepths   map[int]int
+		shouldFail       bool
+	}{
+		"undirected graph with cycle and unreachable vertex": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			source:           1,
+			expectedVertices: []int{1, 2, 3, 4, 5},
+			expectedDepths:   map[int]int{1: 0, 2: 1, 3: 1, 4: 2, 5: 3},
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+				{Source: 4, Target: 1},
+			},
+			source:           1,
+			expectedVertices: []int{1, 2, 3},
+			expectedDepths:   map[int]int{1: 0, 2: 1, 3: 1},
+		},
+		"unknown source vertex": {
+			vertices:   []int{1},
+			source:     2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		tree, err := BFSTree(graph, test.source)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if tree.Order() != len(test.expectedVertices) {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, len(test.expectedVertices), tree.Order())
+		}
+
+		if tree.Size() != len(test.expectedVertices)-1 {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedVertices)-1, tree.Size())
+		}
+
+		// Determine the depth of each vertex in the tree, which must match its BFS distance.
+		adjacencyMap, _ := tree.AdjacencyMap()
+		depths := map[int]int{test.source: 0}
+		queue := []int{test.source}
+
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			for adjacency := range adjacencyMap[current] {
+				if _, ok := depths[adjacency]; !ok {
+					depths[adjacency] = depths[current] + 1
+					queue = append(queue, adjacency)
+				}
+			}
+		}
+
+		for vertex, expectedDepth := range test.expectedDepths {
+			if depths[vertex] != expectedDepth {
+				t.Errorf("%s: depth expectancy of %v doesn't match: expected %v, got %v", name, vertex, expectedDepth, depths[vertex])
+			}
+		}
+	}
+}
+
+func TestBFSParents(t *testing.T) {
+	tests := map[string]struct {
+		isDirected      bool
+		vertices        []int
+		edges           []Edge[int]
+		source          int
+		expectedParents map[int]int
+		shouldFail      bool
+	}{
+		"undirected graph with cycle and unreachable vertex": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			source:          1,
+			expectedParents: map[int]int{2: 1, 3: 1, 4: 2, 5: 4},
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+				{Source: 4, Target: 1},
+			},
+			source:          1,
+			expectedParents: map[int]int{2: 1, 3: 1},
+		},
+		"directed graph with edges back to the source": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			source:          1,
+			expectedParents: map[int]int{2: 1},
+		},
+		"single vertex": {
+			vertices:        []int{1},
+			source:          1,
+			expectedParents: map[int]int{},
+		},
+		"unknown source vertex": {
+			vertices:   []int{1},
+			source:     2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		parents, err := BFSParents(graph, test.source)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(parents) != len(test.expectedParents) {
+			t.Errorf("%s: parent count expectancy doesn't match: expected %v, got %v", name, test.expectedParents, parents)
+		}
+
+		for vertex, expectedParent := range test.expectedParents {
+			if parent, ok := parents[vertex]; !ok || parent != expectedParent {
+				t.Errorf("%s: parent expectancy of %v doesn't match: expected %v, got %v", name, vertex, expectedParent, parent)
+			}
+		}
+
+		// The parents have to form the same tree as BFSTree.
+		tree, err := BFSTree(graph, test.source)
+		if err != nil {
+			t.Fatalf("%s: failed to compute BFS tree: %s", name, err.Error())
+		}
+
+		if tree.Size() != len(parents) {
+			t.Errorf("%s: tree size expectancy doesn't match: expected %v, got %v", name, len(parents), tree.Size())
+		}
+
+		for vertex, parent := range parents {
+			if _, err := tree.Edge(parent, vertex); err != nil {
+				t.Errorf("%s: expected BFS tree to contain edge (%v, %v)", name, parent, vertex)
+			}
+		}
+	}
+}
+
+func TestReverseReachable(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		vertices   []int
+		edges      []Edge[int]
+		vertex     int
+		expected   []int
+		shouldFail bool
+	}{
+		"dependency graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 4, Target: 3},
+				{Source: 3, Target: 5},
+				{Source: 6, Target: 1},
+			},
+			vertex:   3,
+			expected: []int{1, 2, 4, 6},
+		},
+		"vertex on a cycle": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 1, Target: 4},
+			},
+			vertex:   1,
+			expected: []int{2, 3},
+		},
+		"vertex without predecessors": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			vertex:     1,
+			expected:   []int{},
+		},
+		"undirected graph": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			vertex:   3,
+			expected: []int{1, 2},
+		},
+		"unknown vertex": {
+			isDirected: true,
+			vertices:   []int{1},
+			vertex:     2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		reachable, err := ReverseReachable(graph, test.vertex)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		vertices := make([]int, 0, len(reachable))
+		for vertex := range reachable {
+			vertices = append(vertices, vertex)
+		}
+
+		if !slicesAreEqual(vertices, test.expected) {
+			t.Errorf("%s: reachable vertices expectancy doesn't match: expected %v, got %v", name, test.expected, vertices)
+		}
+	}
+}
+
+func TestReachableWithin(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		vertices   []int
+		edges      []Edge[int]
+		source     int
+		maxHops    int
+		expected   map[int]int
+		shouldFail bool
+	}{
+		"directed graph within two hops": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 6, Target: 1},
+			},
+			source:   1,
+			maxHops:  2,
+			expected: map[int]int{1: 0, 2: 1, 3: 1, 4: 2},
+		},
+		"shortest hop distance is used": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			source:   1,
+			maxHops:  5,
+			expected: map[int]int{1: 0, 2: 1, 3: 1},
+		},
+		"undirected graph": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			source:   3,
+			maxHops:  1,
+			expected: map[int]int{2: 1, 3: 0, 4: 1},
+		},
+		"zero hops": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			source:     1,
+			maxHops:    0,
+			expected:   map[int]int{1: 0},
+		},
+		"negative hops": {
+			vertices:   []int{1},
+			source:     1,
+			maxHops:    -1,
+			shouldFail: true,
+		},
+		"unknown source": {
+			vertices:   []int{1},
+			source:     2,
+			maxHops:    1,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		distances, err := ReachableWithin(graph, test.source, test.maxHops)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(distances) != len(test.expected) {
+			t.Fatalf("%s: distances expectancy doesn't match: expected %v, got %v", name, test.expected, distances)
+		}
+
+		for vertex, expectedDistance := range test.expected {
+			if distance, ok := distances[vertex]; !ok || distance != expectedDistance {
+				t.Errorf("%s: distance of vertex %v doesn't match: expected %v, got %v", name, vertex, expectedDistance, distance)
+			}
+		}
+	}
+}
+
+func TestUnreachablePairs(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []int
+		edges         []Edge[int]
+		expectedPairs [][2]int
+	}{
+		"directed path": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedPairs: [][2]int{{2, 1}, {3, 1}, {3, 2}},
+		},
+		"directed cycle": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedPairs: [][2]int{},
+		},
+		"undirected graph with two components": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedPairs: [][2]int{{1, 3}, {2, 3}, {3, 1}, {3, 2}},
+		},
+		"empty graph": {
+			expectedPairs: [][2]int{},
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		pairs, err := UnreachablePairs(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if !orderedSlicesAreEqual(pairs, test.expectedPairs) {
+			t.Errorf("%s: pairs expectancy doesn't match: expected %v, got %v", name, test.expectedPairs, pairs)
+		}
+	}
+}
+
+func TestVisitUnreachablePairs(t *testing.T) {
+	graph := New(IntHash, Directed())
+
+	for i := 1; i <= 4; i++ {
+		_ = graph.AddVertex(i)
+	}
+
+	visited := make([][2]int, 0)
+
+	err := VisitUnreachablePairs(graph, func(source, target int) bool {
+		visited = append(visited, [2]int{source, target})
+		return len(visited) == 2
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	expected := [][2]int{{1, 2}, {1, 3}}
+
+	if !orderedSlicesAreEqual(visited, expected) {
+		t.Errorf("visited pairs expectancy doesn't match: expected %v, got %v", expected, visited)
+	}
+}
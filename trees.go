@@ -0,0 +1,567 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// MinimumSpanningTree computes a minimum spanning tree of an undirected graph using Kruskal's
+// algorithm and returns it as a new graph along with its total weight, i.e. the sum of its edge
+// weights. A minimum spanning tree connects all vertices with the smallest possible total weight.
+//
+// If the graph is disconnected, the result is a minimum spanning forest consisting of a minimum
+// spanning tree for each connected component. Self-loops are never part of the tree. The tree
+// edges keep their weights and attributes, and the tree has the same traits as the graph.
+func MinimumSpanningTree[K comparable, T any](g Graph[K, T]) (Graph[K, T], int, error) {
+	return spanningTree(g, false)
+}
+
+// MaximumSpanningTree computes a maximum spanning tree of an undirected graph and returns it as a
+// new graph along with its total weight. It works like MinimumSpanningTree, but the resulting
+// tree has the largest possible total weight.
+func MaximumSpanningTree[K comparable, T any](g Graph[K, T]) (Graph[K, T], int, error) {
+	return spanningTree(g, true)
+}
+
+func spanningTree[K comparable, T any](g Graph[K, T], maximum bool) (Graph[K, T], int, error) {
+	if g.Traits().IsDirected {
+		return nil, 0, errors.New("spanning trees can only be computed for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	tree, err := newLike(g)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create tree: %w", err)
+	}
+
+	for vertex := range adjacencyMap {
+		if err := addVertexFrom(tree, g, vertex); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// Edges with the same weight are always considered in the same order, so that the resulting
+	// tree is deterministic.
+	edges := edgesByWeight(adjacencyMap, false, !maximum)
+
+	components := newUnionFind[K]()
+	totalWeight := 0
+
+	for _, edge := range edges {
+		if !components.union(edge.Source, edge.Target) {
+			continue
+		}
+
+		if err := tree.AddEdge(edge.Source, edge.Target, copyEdgeProperties(edge.Properties)); err != nil {
+			return nil, 0, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+
+		totalWeight += edge.Properties.Weight
+	}
+
+	return tree, totalWeight, nil
+}
+
+// MinimumArborescence computes a minimum spanning arborescence of a directed graph using the
+// Chu-Liu/Edmonds algorithm and returns it as a new graph along with its total weight. A spanning
+// arborescence is the directed analog of a spanning tree: It contains exactly one path from the
+// given root vertex to each other vertex, so every vertex except the root has exactly one incoming
+// edge. A minimum spanning arborescence has the smallest possible total weight.
+//
+// If not all vertices are reachable from the root, no spanning arborescence exists and an error
+// will be returned. Self-loops and edges into the root are never part of the arborescence. Among
+// arborescences with the same total weight, the result is deterministic. The arborescence edges
+// keep their weights and attributes, and the arborescence has the same traits as the graph.
+func MinimumArborescence[K comparable, T any](g Graph[K, T], root K) (Graph[K, T], int, error) {
+	if !g.Traits().IsDirected {
+		return nil, 0, errors.New("arborescences can only be computed for directed graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[root]; !ok {
+		return nil, 0, fmt.Errorf("could not find root vertex with hash %v", root)
+	}
+
+	reachable := map[K]bool{root: true}
+	stack := []K{root}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for adjacency := range adjacencyMap[current] {
+			if !reachable[adjacency] {
+				reachable[adjacency] = true
+				stack = append(stack, adjacency)
+			}
+		}
+	}
+
+	if len(reachable) != len(adjacencyMap) {
+		for _, vertex := range sortedMapKeys(adjacencyMap) {
+			if !reachable[vertex] {
+				return nil, 0, fmt.Errorf("%w: %v -> %v", ErrTargetNotReachable, root, vertex)
+			}
+		}
+	}
+
+	vertices := sortedMapKeys(adjacencyMap)
+	indices := make(map[K]int, len(vertices))
+
+	for i, vertex := range vertices {
+		indices[vertex] = i
+	}
+
+	edges := sortedEdges(adjacencyMap, true)
+	arcs := make([]arborescenceArc, len(edges))
+
+	for i, edge := range edges {
+		arcs[i] = arborescenceArc{
+			source: indices[edge.Source],
+			target: indices[edge.Target],
+			weight: edge.Properties.Weight,
+			index:  i,
+		}
+	}
+
+	arborescence, err := newLike(g)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create arborescence: %w", err)
+	}
+
+	for _, vertex := range vertices {
+		if err := addVertexFrom(arborescence, g, vertex); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	selected := minimumArborescence(len(vertices), indices[root], arcs)
+	sort.Ints(selected)
+
+	totalWeight := 0
+
+	for _, index := range selected {
+		edge := edges[index]
+
+		if err := arborescence.AddEdge(edge.Source, edge.Target, copyEdgeProperties(edge.Properties)); err != nil {
+			return nil, 0, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+
+		totalWeight += edge.Properties.Weight
+	}
+
+	return arborescence, totalWeight, nil
+}
+
+// arborescenceArc is an edge of the graph processed by minimumArborescence, whose vertices are
+// numbered from 0 to n-1. The index refers to the arc in the graph of the previous recursion level
+// that the arc has been created from.
+type arborescenceArc struct {
+	source int
+	target int
+	weight int
+	index  int
+}
+
+// minimumArborescence implements the Chu-Liu/Edmonds algorithm for a graph with n vertices in
+// which all vertices are reachable from the root. It returns the indices of the selected arcs.
+//
+// Each vertex except the root chooses its cheapest incoming arc. If these arcs don't form a cycle,
+// they are the minimum arborescence. Otherwise, each cycle is contracted into a single vertex, the
+// weight of each arc entering a cycle is reduced by the weight of the cycle arc it would replace,
+// and the algorithm recurses on the contracted graph. Each cycle is then expanded by keeping all
+// of its arcs except the one entering the vertex where the selected arc enters the cycle.
+func minimumArborescence(n, root int, arcs []arborescenceArc) []int {
+	minIn := make([]int, n)
+	for i := range minIn {
+		minIn[i] = -1
+	}
+
+	for i, arc := range arcs {
+		if arc.target == root || arc.source == arc.target {
+			continue
+		}
+		if minIn[arc.target] == -1 || arc.weight < arcs[minIn[arc.target]].weight {
+			minIn[arc.target] = i
+		}
+	}
+
+	component := make([]int, n)
+	visitedBy := make([]int, n)
+	onCycle := make([]bool, n)
+
+	for i := range component {
+		component[i] = -1
+		visitedBy[i] = -1
+	}
+
+	count := 0
+
+	for vertex := 0; vertex < n; vertex++ {
+		current := vertex
+		for current != root && visitedBy[current] == -1 && component[current] == -1 {
+			visitedBy[current] = vertex
+			current = arcs[minIn[current]].source
+		}
+
+		if current == root || visitedBy[current] != vertex || component[current] != -1 {
+			continue
+		}
+
+		// The walk has returned to a vertex visited by itself, so that vertex lies on a cycle.
+		for cycleVertex := current; ; {
+			component[cycleVertex] = count
+			onCycle[cycleVertex] = true
+			cycleVertex = arcs[minIn[cycleVertex]].source
+			if cycleVertex == current {
+				break
+			}
+		}
+		count++
+	}
+
+	if count == 0 {
+		selected := make([]int, 0, n-1)
+		for vertex, arc := range minIn {
+			if vertex != root {
+				selected = append(selected, arc)
+			}
+		}
+		return selected
+	}
+
+	for vertex := range component {
+		if component[vertex] == -1 {
+			component[vertex] = count
+			count++
+		}
+	}
+
+	contracted := make([]arborescenceArc, 0, len(arcs))
+
+	for i, arc := range arcs {
+		source, target := component[arc.source], component[arc.target]
+		if source == target || arc.target == root {
+			continue
+		}
+
+		weight := arc.weight
+		if onCycle[arc.target] {
+			weight -= arcs[minIn[arc.target]].weight
+		}
+
+		contracted = append(contracted, arborescenceArc{
+			source: source,
+			target: target,
+			weight: weight,
+			index:  i,
+		})
+	}
+
+	entered := make([]bool, n)
+	selected := make([]int, 0, n-1)
+
+	for _, arc := range minimumArborescence(count, component[root], contracted) {
+		index := contracted[arc].index
+		entered[arcs[index].target] = true
+		selected = append(selected, index)
+	}
+
+	for vertex := range minIn {
+		if onCycle[vertex] && !entered[vertex] {
+			selected = append(selected, minIn[vertex])
+		}
+	}
+
+	return selected
+}
+
+// AllSpanningTrees enumerates all spanning trees of a connected undirected graph and returns each
+// of them as a new graph. The tree edges keep their weights and attributes, and each tree has the
+// same traits as the graph. A disconnected graph has no spanning trees, so an empty slice will be
+// returned for it. Self-loops are never part of a spanning tree.
+//
+// The number of spanning trees grows exponentially with the size of the graph: A complete graph
+// with n vertices has n^(n-2) spanning trees, which already exceeds 100 million for n = 10. Thus,
+// AllSpanningTrees is only suitable for small graphs. Use SpanningTreeCount to check the number of
+// spanning trees beforehand, or VisitSpanningTrees to process the trees one by one.
+func AllSpanningTrees[K comparable, T any](g Graph[K, T]) ([]Graph[K, T], error) {
+	trees := make([]Graph[K, T], 0)
+
+	err := VisitSpanningTrees(g, func(tree Graph[K, T]) bool {
+		trees = append(trees, tree)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trees, nil
+}
+
+// VisitSpanningTrees enumerates all spanning trees of a connected undirected graph like
+// AllSpanningTrees does, but calls the visit function for each tree instead of collecting them.
+// If the visit function returns true, the enumeration stops. This allows processing graphs with a
+// huge number of spanning trees without keeping them in memory, and stopping early.
+//
+// The trees are enumerated by recursively deciding for each edge whether it is part of the tree,
+// skipping decisions that cannot lead to a spanning tree. This takes polynomial time per tree.
+func VisitSpanningTrees[K comparable, T any](g Graph[K, T], visit func(Graph[K, T]) bool) error {
+	if g.Traits().IsDirected {
+		return errors.New("spanning trees can only be enumerated for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if len(adjacencyMap) == 0 {
+		return errors.New("spanning trees can only be enumerated for graphs with at least one vertex")
+	}
+
+	edges := make([]Edge[K], 0)
+	for _, edge := range sortedEdges(adjacencyMap, false) {
+		if edge.Source != edge.Target {
+			edges = append(edges, edge)
+		}
+	}
+
+	enumeration := &spanningTreeEnumeration[K, T]{
+		g:            g,
+		adjacencyMap: adjacencyMap,
+		edges:        edges,
+		visit:        visit,
+	}
+
+	if !enumeration.connectsAll(nil, edges) {
+		return nil
+	}
+
+	_, err = enumeration.enumerate(0, nil)
+
+	return err
+}
+
+// spanningTreeEnumeration holds the state of VisitSpanningTrees.
+type spanningTreeEnumeration[K comparable, T any] struct {
+	g            Graph[K, T]
+	adjacencyMap map[K]map[K]Edge[K]
+	edges        []Edge[K]
+	visit        func(Graph[K, T]) bool
+}
+
+// enumerate decides whether the edge at the given index is part of the tree, given the previously
+// chosen edges, and reports whether the enumeration has been stopped by the visit function.
+func (s *spanningTreeEnumeration[K, T]) enumerate(index int, chosen []Edge[K]) (bool, error) {
+	if len(chosen) == len(s.adjacencyMap)-1 {
+		tree, err := s.tree(chosen)
+		if err != nil {
+			return true, err
+		}
+		return s.visit(tree), nil
+	}
+
+	if index == len(s.edges) {
+		return false, nil
+	}
+
+	edge := s.edges[index]
+
+	// Include the edge if it doesn't close a cycle with the chosen edges.
+	if !s.connects(chosen, edge.Source, edge.Target) {
+		included := append(chosen[:len(chosen):len(chosen)], edge)
+		if stop, err := s.enumerate(index+1, included); stop || err != nil {
+			return stop, err
+		}
+	}
+
+	// Exclude the edge if the chosen and the remaining edges can still connect all vertices.
+	if s.connectsAll(chosen, s.edges[index+1:]) {
+		return s.enumerate(index+1, chosen)
+	}
+
+	return false, nil
+}
+
+// connectsAll reports whether the chosen and the remaining edges together connect all vertices.
+func (s *spanningTreeEnumeration[K, T]) connectsAll(chosen, remaining []Edge[K]) bool {
+	components := newUnionFind[K]()
+	count := len(s.adjacencyMap)
+
+	for _, edges := range [][]Edge[K]{chosen, remaining} {
+		for _, edge := range edges {
+			if components.union(edge.Source, edge.Target) {
+				count--
+			}
+		}
+	}
+
+	return count == 1
+}
+
+// connects reports whether the chosen edges connect the vertices a and b.
+func (s *spanningTreeEnumeration[K, T]) connects(chosen []Edge[K], a, b K) bool {
+	components := newUnionFind[K]()
+
+	for _, edge := range chosen {
+		components.union(edge.Source, edge.Target)
+	}
+
+	return components.find(a) == components.find(b)
+}
+
+// tree creates a new graph containing all vertices and the given edges.
+func (s *spanningTreeEnumeration[K, T]) tree(edges []Edge[K]) (Graph[K, T], error) {
+	tree, err := newLike(s.g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tree: %w", err)
+	}
+
+	for vertex := range s.adjacencyMap {
+		if err := addVertexFrom(tree, s.g, vertex); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, edge := range edges {
+		if err := tree.AddEdge(edge.Source, edge.Target, copyEdgeProperties(edge.Properties)); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return tree, nil
+}
+
+// EulerTour computes the Euler tour of a rooted tree, which is the sequence of vertices visited by
+// a depth-first search starting at the root, where a vertex is appended again each time the search
+// returns to it from one of its children. For a tree with n vertices, the tour has 2n-1 entries.
+//
+// Along with the tour, EulerTour returns the index of the first occurrence of each vertex in the
+// tour and the depth of the vertex at each tour position, with the root having a depth of 0. This
+// is the standard preprocessing for answering lowest common ancestor queries: The lowest common
+// ancestor of two vertices is the vertex with the minimum depth between their first occurrences.
+//
+// The children of a vertex are visited in ascending order of their hashes. The graph must have the
+// Rooted and Acyclic traits, which can be set at once using Tree. For undirected graphs, the
+// children of a vertex are all adjacent vertices except for its parent. If a vertex is reachable
+// via more than one path, i.e. the graph is not a tree, an error will be returned. Vertices that
+// aren't reachable from the root are not part of the tour.
+func EulerTour[K comparable, T any](g Graph[K, T], root K) ([]K, map[K]int, []int, error) {
+	if !g.Traits().IsRooted || !g.Traits().IsAcyclic {
+		return nil, nil, nil, errors.New("euler tours can only be computed for rooted, acyclic graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[root]; !ok {
+		return nil, nil, nil, fmt.Errorf("could not find root vertex with hash %v", root)
+	}
+
+	isDirected := g.Traits().IsDirected
+
+	type frame struct {
+		vertex   K
+		children []K
+		next     int
+	}
+
+	visit := func(vertex, parent K, isRoot bool) frame {
+		children := make([]K, 0, len(adjacencyMap[vertex]))
+		for adjacency := range adjacencyMap[vertex] {
+			if !isDirected && !isRoot && adjacency == parent {
+				continue
+			}
+			children = append(children, adjacency)
+		}
+		sortKeys(children)
+
+		return frame{vertex: vertex, children: children}
+	}
+
+	tour := []K{root}
+	first := map[K]int{root: 0}
+	depth := []int{0}
+	stack := []frame{visit(root, root, true)}
+
+	// The search is iterative rather than recursive, so that deep trees can't exhaust the stack.
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+
+		if top.next == len(top.children) {
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				tour = append(tour, stack[len(stack)-1].vertex)
+				depth = append(depth, len(stack)-1)
+			}
+			continue
+		}
+
+		child := top.children[top.next]
+		top.next++
+
+		if _, ok := first[child]; ok {
+			return nil, nil, nil, fmt.Errorf("vertex %v is reachable via multiple paths, so the graph is not a tree", child)
+		}
+
+		first[child] = len(tour)
+		tour = append(tour, child)
+		depth = append(depth, len(stack))
+		stack = append(stack, visit(child, top.vertex, false))
+	}
+
+	return tour, first, depth, nil
+}
+
+// unionFind is a disjoint-set data structure with path compression, which keeps track of a set of
+// vertices partitioned into disjoint subsets. Vertices that haven't been added explicitly form a
+// subset on their own.
+type unionFind[K comparable] struct {
+	parents map[K]K
+}
+
+func newUnionFind[K comparable]() *unionFind[K] {
+	return &unionFind[K]{
+		parents: make(map[K]K),
+	}
+}
+
+// find returns the representative of the subset containing the given vertex.
+func (u *unionFind[K]) find(vertex K) K {
+	parent, ok := u.parents[vertex]
+	if !ok || parent == vertex {
+		return vertex
+	}
+
+	root := u.find(parent)
+	u.parents[vertex] = root
+
+	return root
+}
+
+// union merges the subsets containing the two given vertices. It returns false if the vertices
+// already are in the same subset.
+func (u *unionFind[K]) union(a, b K) bool {
+	aRoot := u.find(a)
+	bRoot := u.find(b)
+
+	if aRoot == bRoot {
+		return false
+	}
+
+	u.parents[aRoot] = bRoot
+
+	return true
+}
@@ -0,0 +1,630 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	tests := map[string]struct {
+		isDirected     bool
+		vertices       []string
+		edges          []Edge[string]
+		expectedEdges  []Edge[string]
+		expectedWeight int
+		shouldFail     bool
+	}{
+		"weighted graph": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 4}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedEdges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "A", Target: "C"},
+				{Source: "C", Target: "D"},
+			},
+			expectedWeight: 6,
+		},
+		"disconnected graph with self-loop": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 7}},
+				{Source: "D", Target: "D", Properties: EdgeProperties{Weight: -5}},
+			},
+			expectedEdges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "C", Target: "D"},
+			},
+			expectedWeight: 9,
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[string, string]
+		if test.isDirected {
+			graph = New(StringHash, Directed(), Weighted())
+		} else {
+			graph = New(StringHash, Weighted())
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		tree, weight, err := MinimumSpanningTree(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if weight != test.expectedWeight {
+			t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, test.expectedWeight, weight)
+		}
+
+		if tree.Order() != len(test.vertices) {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, len(test.vertices), tree.Order())
+		}
+
+		if tree.Size() != len(test.expectedEdges) {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), tree.Size())
+		}
+
+		for _, expectedEdge := range test.expectedEdges {
+			if _, err := tree.Edge(expectedEdge.Source, expectedEdge.Target); err != nil {
+				t.Errorf("%s: edge (%v, %v) expected in tree", name, expectedEdge.Source, expectedEdge.Target)
+			}
+		}
+	}
+}
+
+func TestMaximumSpanningTree(t *testing.T) {
+	tests := map[string]struct {
+		vertices       []string
+		edges          []Edge[string]
+		expectedEdges  []Edge[string]
+		expectedWeight int
+	}{
+		"weighted graph": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 4}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedEdges: []Edge[string]{
+				{Source: "B", Target: "D"},
+				{Source: "B", Target: "C"},
+				{Source: "A", Target: "C"},
+			},
+			expectedWeight: 12,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(StringHash, Weighted())
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		tree, weight, err := MaximumSpanningTree(graph)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if weight != test.expectedWeight {
+			t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, test.expectedWeight, weight)
+		}
+
+		if tree.Size() != len(test.expectedEdges) {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), tree.Size())
+		}
+
+		for _, expectedEdge := range test.expectedEdges {
+			if _, err := tree.Edge(expectedEdge.Source, expectedEdge.Target); err != nil {
+				t.Errorf("%s: edge (%v, %v) expected in tree", name, expectedEdge.Source, expectedEdge.Target)
+			}
+		}
+	}
+}
+
+func TestMinimumArborescence(t *testing.T) {
+	tests := map[string]struct {
+		isDirected     bool
+		vertices       []string
+		edges          []Edge[string]
+		root           string
+		expectedEdges  []Edge[string]
+		expectedWeight int
+		shouldFail     bool
+	}{
+		"cheapest incoming edges form an arborescence": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 5}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 3}},
+			},
+			root: "A",
+			expectedEdges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+				{Source: "C", Target: "D"},
+			},
+			expectedWeight: 4,
+		},
+		"cycle of cheapest incoming edges": {
+			isDirected: true,
+			vertices:   []string{"R", "A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "R", Target: "A", Properties: EdgeProperties{Weight: 10}},
+				{Source: "R", Target: "B", Properties: EdgeProperties{Weight: 8}},
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "A", Properties: EdgeProperties{Weight: 1}},
+				{Source: "R", Target: "C", Properties: EdgeProperties{Weight: 9}},
+			},
+			root: "R",
+			expectedEdges: []Edge[string]{
+				{Source: "R", Target: "B"},
+				{Source: "B", Target: "C"},
+				{Source: "C", Target: "A"},
+			},
+			expectedWeight: 10,
+		},
+		"edges into the root and self-loops are ignored": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 4}},
+				{Source: "B", Target: "A", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "B", Properties: EdgeProperties{Weight: 0}},
+			},
+			root: "A",
+			expectedEdges: []Edge[string]{
+				{Source: "A", Target: "B"},
+			},
+			expectedWeight: 4,
+		},
+		"single vertex": {
+			isDirected:     true,
+			vertices:       []string{"A"},
+			root:           "A",
+			expectedEdges:  []Edge[string]{},
+			expectedWeight: 0,
+		},
+		"vertex not reachable from the root": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "B", Properties: EdgeProperties{Weight: 1}},
+			},
+			root:       "A",
+			shouldFail: true,
+		},
+		"undirected graph": {
+			vertices:   []string{"A"},
+			root:       "A",
+			shouldFail: true,
+		},
+		"unknown root": {
+			isDirected: true,
+			vertices:   []string{"A"},
+			root:       "B",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		options := []func(*Traits){Weighted()}
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(StringHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		arborescence, weight, err := MinimumArborescence(graph, test.root)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if weight != test.expectedWeight {
+			t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, test.expectedWeight, weight)
+		}
+
+		if arborescence.Order() != len(test.vertices) {
+			t.Errorf("%s: order expectancy doesn't match: expected %v, got %v", name, len(test.vertices), arborescence.Order())
+		}
+
+		if arborescence.Size() != len(test.expectedEdges) {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), arborescence.Size())
+		}
+
+		for _, expectedEdge := range test.expectedEdges {
+			if _, err := arborescence.Edge(expectedEdge.Source, expectedEdge.Target); err != nil {
+				t.Errorf("%s: edge (%v, %v) expected in arborescence", name, expectedEdge.Source, expectedEdge.Target)
+			}
+		}
+	}
+}
+
+// TestMinimumArborescence_Random compares the weight of the arborescence computed for random
+// graphs with the minimum weight found by trying all combinations of incoming edges.
+func TestMinimumArborescence_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for round := 0; round < 100; round++ {
+		n := 6
+		graph := New(IntHash, Directed(), Weighted())
+
+		for i := 0; i < n; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		for i := 1; i < n; i++ {
+			_ = graph.AddEdge(rng.Intn(i), i, EdgeWeight(rng.Intn(20)))
+		}
+
+		for i := 0; i < 2*n; i++ {
+			_ = graph.AddEdge(rng.Intn(n), rng.Intn(n), EdgeWeight(rng.Intn(20)))
+		}
+
+		arborescence, weight, err := MinimumArborescence(graph, 0)
+		if err != nil {
+			t.Fatalf("round %d: unexpected error: %s", round, err.Error())
+		}
+
+		if arborescence.Size() != n-1 {
+			t.Fatalf("round %d: size expectancy doesn't match: expected %v, got %v", round, n-1, arborescence.Size())
+		}
+
+		reachable := 0
+		_ = BFS(arborescence, 0, func(int) bool {
+			reachable++
+			return false
+		})
+
+		if reachable != n {
+			t.Fatalf("round %d: only %d of %d vertices are reachable in the arborescence", round, reachable, n)
+		}
+
+		predecessorMap, _ := graph.PredecessorMap()
+		parents := make([]int, n)
+		expectedWeight := -1
+
+		var choose func(vertex, total int)
+
+		choose = func(vertex, total int) {
+			if vertex == n {
+				for start := 1; start < n; start++ {
+					steps := 0
+					for current := start; current != 0 && steps <= n; current = parents[current] {
+						steps++
+					}
+					if steps > n {
+						return
+					}
+				}
+				if expectedWeight == -1 || total < expectedWeight {
+					expectedWeight = total
+				}
+				return
+			}
+
+			for parent, edge := range predecessorMap[vertex] {
+				if parent == vertex {
+					continue
+				}
+				parents[vertex] = parent
+				choose(vertex+1, total+edge.Properties.Weight)
+			}
+		}
+
+		choose(1, 0)
+
+		if weight != expectedWeight {
+			t.Errorf("round %d: weight expectancy doesn't match: expected %v, got %v", round, expectedWeight, weight)
+		}
+	}
+}
+
+func TestAllSpanningTrees(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		vertices      []int
+		edges         []Edge[int]
+		expectedCount int
+		shouldFail    bool
+	}{
+		"triangle": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedCount: 3,
+		},
+		"complete graph with 4 vertices": {
+			vertices:      []int{1, 2, 3, 4},
+			edges:         completeEdges(1, 2, 3, 4),
+			expectedCount: 16,
+		},
+		"path with self-loop": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 3},
+			},
+			expectedCount: 1,
+		},
+		"single vertex": {
+			vertices:      []int{1},
+			expectedCount: 1,
+		},
+		"disconnected graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedCount: 0,
+		},
+		"empty graph": {
+			shouldFail: true,
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		trees, err := AllSpanningTrees(graph)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if len(trees) != test.expectedCount {
+			t.Fatalf("%s: tree count expectancy doesn't match: expected %v, got %v", name, test.expectedCount, len(trees))
+		}
+
+		seen := make(map[string]bool)
+
+		for _, tree := range trees {
+			if tree.Order() != len(test.vertices) || tree.Size() != len(test.vertices)-1 {
+				t.Errorf("%s: tree has order %d and size %d", name, tree.Order(), tree.Size())
+			}
+
+			adjacencyMap, _ := tree.AdjacencyMap()
+			key := fmt.Sprint(sortedEdges(adjacencyMap, false))
+
+			if seen[key] {
+				t.Errorf("%s: tree %v has been enumerated twice", name, key)
+			}
+			seen[key] = true
+
+			if distances, _ := UnweightedDistances(tree, test.vertices[0]); len(distances) != len(test.vertices) {
+				t.Errorf("%s: tree %v isn't connected", name, key)
+			}
+		}
+	}
+}
+
+func TestVisitSpanningTrees(t *testing.T) {
+	graph := New(IntHash)
+
+	for i := 1; i <= 4; i++ {
+		_ = graph.AddVertex(i)
+	}
+
+	for _, edge := range completeEdges(1, 2, 3, 4) {
+		_ = graph.AddEdge(edge.Source, edge.Target)
+	}
+
+	visited := 0
+
+	err := VisitSpanningTrees(graph, func(_ Graph[int, int]) bool {
+		visited++
+		return visited == 5
+	})
+	if err != nil {
+		t.Fatalf("failed to visit spanning trees: %s", err.Error())
+	}
+
+	if visited != 5 {
+		t.Errorf("visit count expectancy doesn't match: expected %v, got %v", 5, visited)
+	}
+}
+
+func TestEulerTour(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		vertices      []int
+		edges         []Edge[int]
+		root          int
+		expectedTour  []int
+		expectedFirst map[int]int
+		expectedDepth []int
+		shouldFail    bool
+	}{
+		"directed tree": {
+			traits:   []func(*Traits){Directed(), Tree()},
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 4},
+				{Source: 2, Target: 5},
+			},
+			root:          1,
+			expectedTour:  []int{1, 2, 4, 2, 5, 2, 1, 3, 1},
+			expectedFirst: map[int]int{1: 0, 2: 1, 3: 7, 4: 2, 5: 4},
+			expectedDepth: []int{0, 1, 2, 1, 2, 1, 0, 1, 0},
+		},
+		"undirected tree rooted at a leaf": {
+			traits:   []func(*Traits){Tree()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			root:          3,
+			expectedTour:  []int{3, 2, 1, 2, 3},
+			expectedFirst: map[int]int{1: 2, 2: 1, 3: 0},
+			expectedDepth: []int{0, 1, 2, 1, 0},
+		},
+		"single vertex": {
+			traits:        []func(*Traits){Directed(), Tree()},
+			vertices:      []int{1},
+			root:          1,
+			expectedTour:  []int{1},
+			expectedFirst: map[int]int{1: 0},
+			expectedDepth: []int{0},
+		},
+		"DAG that isn't a tree": {
+			traits:   []func(*Traits){Directed(), Tree()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			root:       1,
+			shouldFail: true,
+		},
+		"graph without tree traits": {
+			traits:     []func(*Traits){Directed()},
+			vertices:   []int{1},
+			root:       1,
+			shouldFail: true,
+		},
+		"unknown root": {
+			traits:     []func(*Traits){Directed(), Tree()},
+			vertices:   []int{1},
+			root:       2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash, test.traits...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		tour, first, depth, err := EulerTour(graph, test.root)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !orderedSlicesAreEqual(tour, test.expectedTour) {
+			t.Errorf("%s: tour expectancy doesn't match: expected %v, got %v", name, test.expectedTour, tour)
+		}
+
+		if !orderedSlicesAreEqual(depth, test.expectedDepth) {
+			t.Errorf("%s: depth expectancy doesn't match: expected %v, got %v", name, test.expectedDepth, depth)
+		}
+
+		if len(first) != len(test.expectedFirst) {
+			t.Fatalf("%s: first occurrence expectancy doesn't match: expected %v, got %v", name, test.expectedFirst, first)
+		}
+
+		for vertex, expectedIndex := range test.expectedFirst {
+			if index, ok := first[vertex]; !ok || index != expectedIndex {
+				t.Errorf("%s: first occurrence of vertex %v doesn't match: expected %v, got %v", name, vertex, expectedIndex, index)
+			}
+		}
+	}
+}
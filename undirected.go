@@ -11,15 +11,20 @@ type undirected[K comparable, T any] struct {
 	vertices map[K]T
 	outEdges map[K]map[K]Edge[T]
 	inEdges  map[K]map[K]Edge[T]
+	// size is the number of edges, maintained by AddEdge and RemoveEdge so that Size is O(1).
+	size int
+	// insertion records the order in which vertices and edges have been added.
+	insertion *insertionSequence[K]
 }
 
 func newUndirected[K comparable, T any](hash Hash[K, T], traits *Traits) *undirected[K, T] {
 	return &undirected[K, T]{
-		hash:     hash,
-		traits:   traits,
-		vertices: make(map[K]T),
-		outEdges: make(map[K]map[K]Edge[T]),
-		inEdges:  make(map[K]map[K]Edge[T]),
+		hash:      hash,
+		traits:    traits,
+		vertices:  make(map[K]T),
+		outEdges:  make(map[K]map[K]Edge[T]),
+		inEdges:   make(map[K]map[K]Edge[T]),
+		insertion: newInsertionSequence[K](),
 	}
 }
 
@@ -30,6 +35,7 @@ func (u *undirected[K, T]) Traits() *Traits {
 func (u *undirected[K, T]) AddVertex(value T) error {
 	hash := u.hash(value)
 	u.vertices[hash] = value
+	u.insertion.addVertex(hash)
 
 	return nil
 }
@@ -43,6 +49,11 @@ func (u *undirected[K, T]) Vertex(hash K) (T, error) {
 	return vertex, nil
 }
 
+func (u *undirected[K, T]) HasVertex(hash K) bool {
+	_, ok := u.vertices[hash]
+	return ok
+}
+
 func (u *undirected[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error {
 	source, ok := u.vertices[sourceHash]
 	if !ok {
@@ -65,7 +76,7 @@ func (u *undirected[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*Ed
 			return fmt.Errorf("failed to check for cycles: %w", err)
 		}
 		if createsCycle {
-			return fmt.Errorf("an edge between %v and %v would introduce a cycle", sourceHash, targetHash)
+			return newCycleError[K, T](u, sourceHash, targetHash)
 		}
 	}
 
@@ -82,27 +93,36 @@ func (u *undirected[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*Ed
 	}
 
 	u.addEdge(sourceHash, targetHash, edge)
+	u.size++
+	u.insertion.addEdge(sourceHash, targetHash)
 
 	return nil
 }
 
 func (u *undirected[K, T]) Edge(sourceHash, targetHash K) (Edge[T], error) {
+	edge, ok := u.LookupEdge(sourceHash, targetHash)
+	if !ok {
+		return Edge[T]{}, ErrEdgeNotFound
+	}
+
+	return edge, nil
+}
+
+func (u *undirected[K, T]) LookupEdge(sourceHash, targetHash K) (Edge[T], bool) {
 	// In an undirected graph, since multigraphs aren't supported, the edge AB is the same as BA.
 	// Therefore, if source[target] cannot be found, this function also looks for target[source].
-	if sourceEdges, ok := u.outEdges[sourceHash]; ok {
-		if edge, ok := sourceEdges[targetHash]; ok {
-			return edge, nil
-		}
+	if edge, ok := u.outEdges[sourceHash][targetHash]; ok {
+		return edge, true
 	}
 
-	targetEdges, ok := u.outEdges[targetHash]
-	if ok {
-		if edge, ok := targetEdges[sourceHash]; ok {
-			return edge, nil
-		}
-	}
+	edge, ok := u.outEdges[targetHash][sourceHash]
+	return edge, ok
+}
 
-	return Edge[T]{}, ErrEdgeNotFound
+func (u *undirected[K, T]) HasEdge(sourceHash, targetHash K) bool {
+	// Since both directions of an undirected edge are stored, checking one of them suffices.
+	_, ok := u.outEdges[sourceHash][targetHash]
+	return ok
 }
 
 func (u *undirected[K, T]) RemoveEdge(source, target K) error {
@@ -115,6 +135,28 @@ func (u *undirected[K, T]) RemoveEdge(source, target K) error {
 	delete(u.outEdges[source], target)
 	delete(u.outEdges[target], source)
 
+	u.size--
+	// The edge is only recorded in the orientation it has been added with.
+	u.insertion.removeEdge(source, target)
+	u.insertion.removeEdge(target, source)
+
+	return nil
+}
+
+func (u *undirected[K, T]) MapEdgeWeights(f func(source, target K, weight int) int) error {
+	for sourceHash, targets := range u.outEdges {
+		for targetHash, edge := range targets {
+			// Each edge is stored for both of its vertices, so only the entry matching the
+			// original edge direction is updated, which in turn updates both entries.
+			if u.hash(edge.Source) != sourceHash {
+				continue
+			}
+
+			edge.Properties.Weight = f(sourceHash, targetHash, edge.Properties.Weight)
+			u.addEdge(sourceHash, targetHash, edge)
+		}
+	}
+
 	return nil
 }
 
@@ -147,6 +189,22 @@ func (u *undirected[K, T]) PredecessorMap() (map[K]map[K]Edge[K], error) {
 	return u.AdjacencyMap()
 }
 
+func (u *undirected[K, T]) PredecessorCount(hash K) (int, error) {
+	if _, ok := u.vertices[hash]; !ok {
+		return 0, fmt.Errorf("vertex with hash %v doesn't exist", hash)
+	}
+
+	return len(u.inEdges[hash]), nil
+}
+
+func (u *undirected[K, T]) SuccessorCount(hash K) (int, error) {
+	if _, ok := u.vertices[hash]; !ok {
+		return 0, fmt.Errorf("vertex with hash %v doesn't exist", hash)
+	}
+
+	return len(u.outEdges[hash]), nil
+}
+
 func (u *undirected[K, T]) Clone() (Graph[K, T], error) {
 	traits := &Traits{
 		IsDirected: u.traits.IsDirected,
@@ -162,11 +220,13 @@ func (u *undirected[K, T]) Clone() (Graph[K, T], error) {
 	}
 
 	return &undirected[K, T]{
-		hash:     u.hash,
-		traits:   traits,
-		vertices: vertices,
-		outEdges: cloneEdges(u.outEdges),
-		inEdges:  cloneEdges(u.inEdges),
+		hash:      u.hash,
+		traits:    traits,
+		vertices:  vertices,
+		outEdges:  cloneEdges(u.outEdges),
+		inEdges:   cloneEdges(u.inEdges),
+		size:      u.size,
+		insertion: u.insertion.clone(),
 	}, nil
 }
 
@@ -175,13 +235,7 @@ func (u *undirected[K, T]) Order() int {
 }
 
 func (u *undirected[K, T]) Size() int {
-	size := 0
-	for _, outEdges := range u.outEdges {
-		size += len(outEdges)
-	}
-
-	// Divide by 2 since every add edge operation on undirected graph is counted twice.
-	return size / 2
+	return u.size
 }
 
 func (u *undirected[K, T]) edgesAreEqual(a, b Edge[T]) bool {
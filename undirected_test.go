@@ -176,6 +176,174 @@ func TestUndirected_Edge(t *testing.T) {
 	}
 }
 
+func TestUndirected_HasVertex(t *testing.T) {
+	tests := map[string]struct {
+		vertices []int
+		hash     int
+		expected bool
+	}{
+		"existing vertex": {
+			vertices: []int{1, 2},
+			hash:     2,
+			expected: true,
+		},
+		"missing vertex": {
+			vertices: []int{1, 2},
+			hash:     3,
+			expected: false,
+		},
+		"empty graph": {
+			hash:     1,
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		if hasVertex := graph.HasVertex(test.hash); hasVertex != test.expected {
+			t.Errorf("%s: vertex expectancy doesn't match: expected %v, got %v", name, test.expected, hasVertex)
+		}
+	}
+}
+
+func TestUndirected_HasEdge(t *testing.T) {
+	tests := map[string]struct {
+		vertices []int
+		edges    []Edge[int]
+		source   int
+		target   int
+		expected bool
+	}{
+		"existing edge": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			source:   1,
+			target:   2,
+			expected: true,
+		},
+		"reversed edge": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			source:   2,
+			target:   1,
+			expected: true,
+		},
+		"missing edge": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			source:   1,
+			target:   3,
+			expected: false,
+		},
+		"missing vertex": {
+			vertices: []int{1},
+			source:   1,
+			target:   5,
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		if hasEdge := graph.HasEdge(test.source, test.target); hasEdge != test.expected {
+			t.Errorf("%s: edge expectancy doesn't match: expected %v, got %v", name, test.expected, hasEdge)
+		}
+	}
+}
+
+func TestUndirected_LookupEdge(t *testing.T) {
+	tests := map[string]struct {
+		vertices       []int
+		edges          []Edge[int]
+		source         int
+		target         int
+		expected       bool
+		expectedWeight int
+	}{
+		"existing edge": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+			},
+			source:         1,
+			target:         2,
+			expected:       true,
+			expectedWeight: 4,
+		},
+		"reversed edge": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+			},
+			source:         2,
+			target:         1,
+			expected:       true,
+			expectedWeight: 4,
+		},
+		"missing edge": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			source:   1,
+			target:   3,
+			expected: false,
+		},
+		"missing vertex": {
+			vertices: []int{1},
+			source:   1,
+			target:   5,
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash, Weighted())
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		edge, ok := graph.LookupEdge(test.source, test.target)
+
+		if ok != test.expected {
+			t.Errorf("%s: edge expectancy doesn't match: expected %v, got %v", name, test.expected, ok)
+		}
+
+		if edge.Properties.Weight != test.expectedWeight {
+			t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, test.expectedWeight, edge.Properties.Weight)
+		}
+	}
+}
+
 func TestUndirected_RemoveEdge(t *testing.T) {
 	tests := map[string]struct {
 		vertices    []int
@@ -230,6 +398,108 @@ func TestUndirected_RemoveEdge(t *testing.T) {
 	}
 }
 
+func TestUndirected_MapEdgeWeights(t *testing.T) {
+	tests := map[string]struct {
+		vertices        []int
+		edges           []Edge[int]
+		f               func(source, target, weight int) int
+		expectedWeights []Edge[int]
+		expectedCalls   int
+	}{
+		"double all weights": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 5}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: -1}},
+			},
+			f: func(_, _, weight int) int {
+				return weight * 2
+			},
+			expectedWeights: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 6}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 10}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: -2}},
+			},
+			expectedCalls: 3,
+		},
+		"weights depending on vertices": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 2},
+				{Source: 3, Target: 3},
+			},
+			f: func(source, target, _ int) int {
+				return source*10 + target
+			},
+			expectedWeights: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 12}},
+				{Source: 3, Target: 2, Properties: EdgeProperties{Weight: 32}},
+				{Source: 3, Target: 3, Properties: EdgeProperties{Weight: 33}},
+			},
+			expectedCalls: 3,
+		},
+		"graph without edges": {
+			vertices: []int{1, 2},
+			f: func(_, _, weight int) int {
+				return weight + 1
+			},
+			expectedCalls: 0,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(IntHash)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		calls := 0
+
+		err := graph.MapEdgeWeights(func(source, target, weight int) int {
+			calls++
+			return test.f(source, target, weight)
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err.Error())
+		}
+
+		if calls != test.expectedCalls {
+			t.Errorf("%s: call count expectancy doesn't match: expected %v, got %v", name, test.expectedCalls, calls)
+		}
+
+		adjacencyMap, _ := graph.AdjacencyMap()
+		predecessorMap, _ := graph.PredecessorMap()
+
+		for _, expected := range test.expectedWeights {
+			edge, err := graph.Edge(expected.Source, expected.Target)
+			if err != nil {
+				t.Fatalf("%s: failed to get edge: %s", name, err.Error())
+			}
+
+			if edge.Properties.Weight != expected.Properties.Weight {
+				t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, expected.Properties.Weight, edge.Properties.Weight)
+			}
+
+			if weight := adjacencyMap[expected.Source][expected.Target].Properties.Weight; weight != expected.Properties.Weight {
+				t.Errorf("%s: adjacency map weight expectancy doesn't match: expected %v, got %v", name, expected.Properties.Weight, weight)
+			}
+
+			if weight := predecessorMap[expected.Target][expected.Source].Properties.Weight; weight != expected.Properties.Weight {
+				t.Errorf("%s: predecessor map weight expectancy doesn't match: expected %v, got %v", name, expected.Properties.Weight, weight)
+			}
+		}
+	}
+}
+
 func TestUndirected_Adjacencies(t *testing.T) {
 	tests := map[string]struct {
 		vertices []int
@@ -416,6 +686,75 @@ func TestUndirected_PredecessorMap(t *testing.T) {
 	}
 }
 
+func TestUndirected_PredecessorAndSuccessorCount(t *testing.T) {
+	tests := map[string]struct {
+		vertices                 []int
+		edges                    []Edge[int]
+		vertex                   int
+		expectedPredecessorCount int
+		expectedSuccessorCount   int
+		shouldFail               bool
+	}{
+		"vertex with several adjacencies": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 2},
+				{Source: 2, Target: 4},
+			},
+			vertex:                   2,
+			expectedPredecessorCount: 3,
+			expectedSuccessorCount:   3,
+		},
+		"vertex without edges": {
+			vertices:                 []int{1, 2},
+			edges:                    []Edge[int]{{Source: 1, Target: 1}},
+			vertex:                   2,
+			expectedPredecessorCount: 0,
+			expectedSuccessorCount:   0,
+		},
+		"non-existent vertex": {
+			vertices:   []int{1},
+			vertex:     2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph := newUndirected(IntHash, &Traits{})
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		predecessorCount, err := graph.PredecessorCount(test.vertex)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		successorCount, err := graph.SuccessorCount(test.vertex)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if predecessorCount != test.expectedPredecessorCount {
+			t.Errorf("%s: predecessor count expectancy doesn't match: expected %d, got %d", name, test.expectedPredecessorCount, predecessorCount)
+		}
+
+		if successorCount != test.expectedSuccessorCount {
+			t.Errorf("%s: successor count expectancy doesn't match: expected %d, got %d", name, test.expectedSuccessorCount, successorCount)
+		}
+	}
+}
+
 func TestUndirected_Clone(t *testing.T) {
 	tests := map[string]struct {
 		vertices []int
@@ -496,6 +835,7 @@ func TestUndirected_OrderAndSize(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []int
 		edges         []Edge[int]
+		removedEdges  []Edge[int]
 		expectedOrder int
 		expectedSize  int
 	}{
@@ -534,6 +874,29 @@ func TestUndirected_OrderAndSize(t *testing.T) {
 			expectedOrder: 2,
 			expectedSize:  0,
 		},
+		"graph with self-loop": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			expectedOrder: 2,
+			expectedSize:  2,
+		},
+		"graph with removed edges": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 3},
+			},
+			removedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 3},
+			},
+			expectedOrder: 3,
+			expectedSize:  1,
+		},
 	}
 
 	for name, test := range tests {
@@ -549,6 +912,12 @@ func TestUndirected_OrderAndSize(t *testing.T) {
 			}
 		}
 
+		for _, edge := range test.removedEdges {
+			if err := graph.RemoveEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to remove edge: %s", name, err.Error())
+			}
+		}
+
 		order := graph.Order()
 		size := graph.Size()
 
@@ -560,6 +929,11 @@ func TestUndirected_OrderAndSize(t *testing.T) {
 			t.Errorf("%s: size expectancy doesn't match: expected %d, got %d", name, test.expectedSize, size)
 		}
 
+		clone, _ := graph.Clone()
+
+		if clone.Size() != test.expectedSize {
+			t.Errorf("%s: clone size expectancy doesn't match: expected %d, got %d", name, test.expectedSize, clone.Size())
+		}
 	}
 }
 
@@ -0,0 +1,247 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ValidationError is returned by Validate if the graph is inconsistent. It contains all problems
+// that have been found in the graph, not only the first one.
+type ValidationError struct {
+	Errors []error
+}
+
+func (v *ValidationError) Error() string {
+	messages := make([]string, len(v.Errors))
+
+	for i, err := range v.Errors {
+		messages[i] = err.Error()
+	}
+
+	return fmt.Sprintf("graph is invalid: %s", strings.Join(messages, "; "))
+}
+
+// Is reports whether any of the problems found in the graph matches the target error. It allows
+// errors.Is to inspect the individual errors.
+func (v *ValidationError) Is(target error) bool {
+	for _, err := range v.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first problem found in the graph that matches the target and sets the target to that
+// error. It allows errors.As to inspect the individual errors.
+func (v *ValidationError) As(target any) bool {
+	for _, err := range v.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate checks the integrity of the given graph. This is useful after manual manipulation or for
+// testing custom graph implementations. Validate checks that
+//
+//   - the source and target vertex of each edge exist in the graph,
+//   - each edge in the adjacency map has a matching entry in the predecessor map and vice versa,
+//   - each edge in an undirected graph is contained in both directions,
+//   - an acyclic graph doesn't contain any cycles and therefore no self-loops.
+//
+// If any of these checks fail, a *ValidationError listing all problems will be returned. The
+// problems are listed in ascending order of the vertex hashes, so the error message is deterministic.
+func Validate[K comparable, T any](g Graph[K, T]) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	var problems []error
+
+	for _, source := range sortedMapKeys(adjacencyMap) {
+		adjacencies := adjacencyMap[source]
+
+		if _, err := g.Vertex(source); err != nil {
+			problems = append(problems, fmt.Errorf("adjacency map contains unknown vertex %v", source))
+		}
+
+		for _, target := range sortedMapKeys(adjacencies) {
+			if _, err := g.Vertex(target); err != nil {
+				problems = append(problems, fmt.Errorf("edge (%v, %v) has unknown target vertex %v", source, target, target))
+			}
+
+			if _, ok := predecessorMap[target][source]; !ok {
+				problems = append(problems, fmt.Errorf("edge (%v, %v) is missing in the predecessor map", source, target))
+			}
+
+			if !g.Traits().IsDirected {
+				if _, ok := adjacencyMap[target][source]; !ok {
+					problems = append(problems, fmt.Errorf("undirected edge (%v, %v) is missing its reverse direction", source, target))
+				}
+			}
+
+			if g.Traits().IsAcyclic && source == target {
+				problems = append(problems, fmt.Errorf("acyclic graph contains a self-loop at vertex %v", source))
+			}
+		}
+	}
+
+	for _, target := range sortedMapKeys(predecessorMap) {
+		predecessors := predecessorMap[target]
+
+		if _, ok := adjacencyMap[target]; !ok {
+			problems = append(problems, fmt.Errorf("predecessor map contains unknown vertex %v", target))
+		}
+
+		for _, source := range sortedMapKeys(predecessors) {
+			if _, ok := adjacencyMap[source][target]; !ok {
+				problems = append(problems, fmt.Errorf("predecessor entry (%v, %v) is missing in the adjacency map", source, target))
+			}
+		}
+	}
+
+	if g.Traits().IsAcyclic && containsCycle(adjacencyMap, g.Traits().IsDirected) {
+		problems = append(problems, errors.New("acyclic graph contains a cycle"))
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{
+			Errors: problems,
+		}
+	}
+
+	return nil
+}
+
+// SelfLoops returns the hashes of all vertices that have an edge to themselves, in ascending order.
+// This is a quick data-quality check, e.g. after importing a graph. The graph isn't modified.
+func SelfLoops[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0)
+
+	for vertex, adjacencies := range adjacencyMap {
+		if _, ok := adjacencies[vertex]; ok {
+			vertices = append(vertices, vertex)
+		}
+	}
+
+	sortKeys(vertices)
+
+	return vertices, nil
+}
+
+// ParallelEdges returns all pairs of vertices that are joined by more than one edge, sorted by their
+// source and target hashes. The graph isn't modified.
+//
+// The adjacency map holds at most one edge for each pair of vertices, and graphs created using New
+// reject parallel edges in AddEdge. Therefore, ParallelEdges currently always returns an empty
+// slice. It is meant for validation code that should keep working once multigraphs are supported.
+func ParallelEdges[K comparable, T any](g Graph[K, T]) ([][2]K, error) {
+	if _, err := g.AdjacencyMap(); err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	return make([][2]K, 0), nil
+}
+
+// containsCycle determines whether the graph represented by the given adjacency map contains a
+// cycle. Self-loops are ignored since they are reported separately.
+func containsCycle[K comparable](adjacencyMap map[K]map[K]Edge[K], isDirected bool) bool {
+	if isDirected {
+		// Use Kahn's algorithm: If not all vertices can be removed in topological order, the
+		// remaining vertices form at least one cycle.
+		inDegrees := make(map[K]int, len(adjacencyMap))
+
+		for vertex := range adjacencyMap {
+			inDegrees[vertex] = 0
+		}
+
+		for vertex := range adjacencyMap {
+			for adjacency := range adjacencyMap[vertex] {
+				if adjacency != vertex {
+					inDegrees[adjacency]++
+				}
+			}
+		}
+
+		queue := make([]K, 0)
+
+		for vertex, inDegree := range inDegrees {
+			if inDegree == 0 {
+				queue = append(queue, vertex)
+			}
+		}
+
+		removed := 0
+
+		for len(queue) > 0 {
+			vertex := queue[0]
+			queue = queue[1:]
+			removed++
+
+			for adjacency := range adjacencyMap[vertex] {
+				if adjacency == vertex {
+					continue
+				}
+				inDegrees[adjacency]--
+				if inDegrees[adjacency] == 0 {
+					queue = append(queue, adjacency)
+				}
+			}
+		}
+
+		return removed != len(inDegrees)
+	}
+
+	// In an undirected graph, an edge joining two vertices that are already connected closes a
+	// cycle. The connectivity is tracked using a union-find structure.
+	parents := make(map[K]K, len(adjacencyMap))
+
+	var find func(K) K
+	find = func(vertex K) K {
+		parent, ok := parents[vertex]
+		if !ok || parent == vertex {
+			return vertex
+		}
+		root := find(parent)
+		parents[vertex] = root
+		return root
+	}
+
+	visited := make(map[K]map[K]bool)
+
+	for vertex, adjacencies := range adjacencyMap {
+		for adjacency := range adjacencies {
+			if adjacency == vertex || visited[adjacency][vertex] {
+				continue
+			}
+			if _, ok := visited[vertex]; !ok {
+				visited[vertex] = make(map[K]bool)
+			}
+			visited[vertex][adjacency] = true
+
+			vertexRoot, adjacencyRoot := find(vertex), find(adjacency)
+			if vertexRoot == adjacencyRoot {
+				return true
+			}
+			parents[vertexRoot] = adjacencyRoot
+		}
+	}
+
+	return false
+}
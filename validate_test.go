@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// manipulatedGraph is a graph whose adjacency and predecessor maps can be overridden in order to
+// simulate an inconsistent graph implementation.
+type manipulatedGraph struct {
+	Graph[int, int]
+	traits         *Traits
+	adjacencyMap   map[int]map[int]Edge[int]
+	predecessorMap map[int]map[int]Edge[int]
+}
+
+func (m *manipulatedGraph) Traits() *Traits {
+	return m.traits
+}
+
+func (m *manipulatedGraph) AdjacencyMap() (map[int]map[int]Edge[int], error) {
+	return m.adjacencyMap, nil
+}
+
+func (m *manipulatedGraph) PredecessorMap() (map[int]map[int]Edge[int], error) {
+	return m.predecessorMap, nil
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		graph            func() Graph[int, int]
+		expectedProblems int
+		expectedMessage  string
+	}{
+		"valid directed graph": {
+			graph: func() Graph[int, int] {
+				g := New(IntHash, Directed(), Acyclic())
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				_ = g.AddVertex(3)
+				_ = g.AddEdge(1, 2)
+				_ = g.AddEdge(2, 3)
+				return g
+			},
+		},
+		"valid undirected graph": {
+			graph: func() Graph[int, int] {
+				g := New(IntHash)
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				_ = g.AddEdge(1, 2)
+				_ = g.AddEdge(2, 2)
+				return g
+			},
+		},
+		"inconsistent maps and unknown vertex": {
+			graph: func() Graph[int, int] {
+				g := New(IntHash, Directed())
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				return &manipulatedGraph{
+					Graph:  g,
+					traits: g.Traits(),
+					adjacencyMap: map[int]map[int]Edge[int]{
+						1: {2: {Source: 1, Target: 2}, 3: {Source: 1, Target: 3}},
+						2: {},
+					},
+					predecessorMap: map[int]map[int]Edge[int]{
+						1: {},
+						2: {},
+					},
+				}
+			},
+			// The edge (1, 3) has an unknown target and is missing in the predecessor map, and the
+			// edge (1, 2) is missing in the predecessor map.
+			expectedProblems: 3,
+			expectedMessage: "graph is invalid: edge (1, 2) is missing in the predecessor map; " +
+				"edge (1, 3) has unknown target vertex 3; edge (1, 3) is missing in the predecessor map",
+		},
+		"cyclic acyclic graph with self-loop": {
+			graph: func() Graph[int, int] {
+				g := New(IntHash, Directed(), Acyclic())
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				return &manipulatedGraph{
+					Graph:  g,
+					traits: g.Traits(),
+					adjacencyMap: map[int]map[int]Edge[int]{
+						1: {1: {Source: 1, Target: 1}, 2: {Source: 1, Target: 2}},
+						2: {1: {Source: 2, Target: 1}},
+					},
+					predecessorMap: map[int]map[int]Edge[int]{
+						1: {1: {Source: 1, Target: 1}, 2: {Source: 2, Target: 1}},
+						2: {1: {Source: 1, Target: 2}},
+					},
+				}
+			},
+			expectedProblems: 2,
+		},
+	}
+
+	for name, test := range tests {
+		err := Validate(test.graph())
+
+		if test.expectedProblems == 0 {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", name, err.Error())
+			}
+			continue
+		}
+
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("%s: error expectancy doesn't match: expected *ValidationError, got %v", name, err)
+		}
+
+		if len(validationErr.Errors) != test.expectedProblems {
+			t.Errorf("%s: problem count expectancy doesn't match: expected %v, got %v (%v)", name, test.expectedProblems, len(validationErr.Errors), err)
+		}
+
+		if test.expectedMessage != "" && err.Error() != test.expectedMessage {
+			t.Errorf("%s: message expectancy doesn't match: expected %q, got %q", name, test.expectedMessage, err.Error())
+		}
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	conflict := &BipartiteConflictError[int]{Source: 1, Target: 2, SourceSide: 0, TargetSide: 0}
+
+	err := error(&ValidationError{
+		Errors: []error{
+			fmt.Errorf("edge (1, 2): %w", ErrEdgeNotFound),
+			fmt.Errorf("partition: %w", conflict),
+		},
+	})
+
+	if !errors.Is(err, ErrEdgeNotFound) {
+		t.Errorf("error expectancy doesn't match: expected %v to match %v", err, ErrEdgeNotFound)
+	}
+
+	if !errors.Is(err, ErrNotBipartite) {
+		t.Errorf("error expectancy doesn't match: expected %v to match %v", err, ErrNotBipartite)
+	}
+
+	if errors.Is(err, ErrTargetNotReachable) {
+		t.Errorf("error expectancy doesn't match: expected %v not to match %v", err, ErrTargetNotReachable)
+	}
+
+	var conflictErr *BipartiteConflictError[int]
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("error expectancy doesn't match: expected %v to contain a *BipartiteConflictError", err)
+	}
+
+	if conflictErr != conflict {
+		t.Errorf("error expectancy doesn't match: expected %v, got %v", conflict, conflictErr)
+	}
+
+	var cycleErr *CycleError[int]
+	if errors.As(err, &cycleErr) {
+		t.Errorf("error expectancy doesn't match: expected %v not to contain a *CycleError", err)
+	}
+}
+
+func TestSelfLoops(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		edges            []Edge[int]
+		expectedVertices []int
+	}{
+		"directed graph with self-loops": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 3, Target: 3},
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 1},
+			},
+			expectedVertices: []int{1, 3},
+		},
+		"undirected graph with self-loop": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 2},
+			},
+			expectedVertices: []int{2},
+		},
+		"graph without self-loops": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedVertices: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		var graph Graph[int, int]
+		if test.isDirected {
+			graph = New(IntHash, Directed())
+		} else {
+			graph = New(IntHash)
+		}
+
+		for i := 1; i <= 3; i++ {
+			_ = graph.AddVertex(i)
+		}
+
+		for _, edge := range test.edges {
+			if err := graph.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		vertices, err := SelfLoops(graph)
+		if err != nil {
+			t.Fatalf("%s: failed to find self-loops: %s", name, err.Error())
+		}
+
+		if !orderedSlicesAreEqual(vertices, test.expectedVertices) {
+			t.Errorf("%s: vertex expectancy doesn't match: expected %v, got %v", name, test.expectedVertices, vertices)
+		}
+
+		if graph.Order() != 3 {
+			t.Errorf("%s: graph has been modified", name)
+		}
+	}
+}
+
+func TestParallelEdges_SimpleGraph(t *testing.T) {
+	graph := New(IntHash, Directed())
+	_ = graph.AddVertex(1)
+	_ = graph.AddVertex(2)
+	_ = graph.AddEdge(1, 2)
+	_ = graph.AddEdge(2, 1)
+
+	if err := graph.AddEdge(1, 2); err == nil {
+		t.Error("expected adding a parallel edge to fail")
+	}
+
+	edges, err := ParallelEdges(graph)
+	if err != nil {
+		t.Fatalf("failed to find parallel edges: %s", err.Error())
+	}
+
+	if len(edges) != 0 {
+		t.Errorf("parallel edge expectancy doesn't match: expected none, got %v", edges)
+	}
+}
@@ -0,0 +1,244 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Number is a constraint that permits all integer and floating-point types. It is used as the type
+// of edge weights by the algorithms that accept a weight function, such as ShortestPathByWeight.
+//
+// The weight of an edge is stored as an int in its EdgeProperties. For decimal costs or other
+// numeric types, these algorithms take a weight function that maps an edge to a weight of any
+// Number type instead of reading EdgeProperties.Weight, which allows to migrate from the int-based
+// API step by step:
+//
+//   - IntWeight returns the stored int weight and reproduces the behavior of the int-based API.
+//   - FloatAttributeWeight reads the weight from an edge attribute, e.g. one set using
+//     EdgeAttribute("cost", "0.25"), and falls back to the stored int weight.
+//   - Any custom function can compute the weight from the edge's attributes.
+//
+// Summed weights are subject to Go's arithmetic rules: Sums of integer weights silently wrap
+// around on overflow, and sums of floating-point weights become +Inf. The weight type has to be
+// large enough for the total weight of the longest path in the graph.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// IntWeight returns a weight function that returns the weight stored in the edge properties. It is
+// the weight function equivalent to the int-based API.
+func IntWeight[K comparable]() func(Edge[K]) int {
+	return func(edge Edge[K]) int {
+		return edge.Properties.Weight
+	}
+}
+
+// FloatAttributeWeight returns a weight function that reads the weight of an edge from the
+// attribute with the given key, parsed as a float64. If the attribute doesn't exist or isn't a
+// valid float, the weight stored in the edge properties is used instead.
+func FloatAttributeWeight[K comparable](key string) func(Edge[K]) float64 {
+	return func(edge Edge[K]) float64 {
+		if weight, ok := edge.Properties.AttributeFloat(key); ok {
+			return weight
+		}
+		return float64(edge.Properties.Weight)
+	}
+}
+
+// ShortestPathByWeight computes the shortest path between a source and a target vertex using
+// Dijkstra's algorithm, just like ShortestPath. However, the weight of each edge is determined by
+// the given weight function, which may return any Number type. ShortestPathByWeight returns the
+// hash values of the vertices forming the path, including source and target, and the total weight
+// of the path.
+//
+// The weight function is called regardless of whether the graph is weighted. Negative weights are
+// not supported and result in an error. If the target cannot be reached from the source vertex,
+// an error wrapping ErrTargetNotReachable will be returned.
+func ShortestPathByWeight[K comparable, T any, W Number](g Graph[K, T], source, target K, weight func(Edge[K]) W) ([]K, W, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, 0, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	if _, ok := adjacencyMap[target]; !ok {
+		return nil, 0, fmt.Errorf("could not find target vertex with hash %v", target)
+	}
+
+	distances := map[K]W{source: 0}
+	predecessors := make(map[K]K)
+	settled := make(map[K]bool)
+
+	queue := &weightQueue[K, W]{}
+	heap.Push(queue, weightItem[K, W]{hash: source, weight: 0})
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(weightItem[K, W])
+
+		if settled[item.hash] {
+			continue
+		}
+		settled[item.hash] = true
+
+		if item.hash == target {
+			break
+		}
+
+		for adjacency, edge := range adjacencyMap[item.hash] {
+			edgeWeight := weight(edge)
+			if edgeWeight < 0 {
+				return nil, 0, fmt.Errorf("edge (%v, %v) has a negative weight", item.hash, adjacency)
+			}
+
+			distance := item.weight + edgeWeight
+			if existing, ok := distances[adjacency]; ok && existing <= distance {
+				continue
+			}
+
+			distances[adjacency] = distance
+			predecessors[adjacency] = item.hash
+			heap.Push(queue, weightItem[K, W]{hash: adjacency, weight: distance})
+		}
+	}
+
+	if !settled[target] {
+		return nil, 0, fmt.Errorf("%w: %v -> %v", ErrTargetNotReachable, source, target)
+	}
+
+	path := []K{target}
+	for vertex := target; vertex != source; {
+		vertex = predecessors[vertex]
+		path = append(path, vertex)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, distances[target], nil
+}
+
+// ShortestPathWithWeight computes the shortest path between a source and a target vertex like
+// ShortestPath does, but determines the weight of each edge using the given weight function
+// instead of the stored edge weight. For example, this allows to route by a latency stored in an
+// edge attribute:
+//
+//	path, err := graph.ShortestPathWithWeight(g, "A", "B", func(e graph.Edge[string]) int {
+//		latency, _ := e.Properties.AttributeInt("latency")
+//		return latency
+//	})
+//
+// The weight function is called regardless of whether the graph is weighted. If it returns a
+// negative weight for an edge, an error will be returned. For weights of other numeric types, use
+// ShortestPathByWeight.
+func ShortestPathWithWeight[K comparable, T any](g Graph[K, T], source, target K, weight func(e Edge[K]) int) ([]K, error) {
+	path, _, err := ShortestPathByWeight(g, source, target, weight)
+	return path, err
+}
+
+// MinimumSpanningTreeByWeight computes a minimum spanning tree of an undirected graph just like
+// MinimumSpanningTree, but determines the weight of each edge using the given weight function. It
+// returns the tree as a new graph along with its total weight. The tree edges keep their stored
+// weights and attributes.
+func MinimumSpanningTreeByWeight[K comparable, T any, W Number](g Graph[K, T], weight func(Edge[K]) W) (Graph[K, T], W, error) {
+	if g.Traits().IsDirected {
+		return nil, 0, errors.New("spanning trees can only be computed for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	tree, err := newLike(g)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create tree: %w", err)
+	}
+
+	for vertex := range adjacencyMap {
+		if err := addVertexFrom(tree, g, vertex); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	edges := sortedEdges(adjacencyMap, false)
+	weights := make([]W, len(edges))
+
+	for i, edge := range edges {
+		weights[i] = weight(edge)
+	}
+
+	// The edges are sorted stably, so that edges with the same weight are always considered in the
+	// same order and the resulting tree is deterministic.
+	indices := make([]int, len(edges))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		return weights[indices[i]] < weights[indices[j]]
+	})
+
+	components := newUnionFind[K]()
+	var totalWeight W
+
+	for _, index := range indices {
+		edge := edges[index]
+
+		if !components.union(edge.Source, edge.Target) {
+			continue
+		}
+
+		if err := tree.AddEdge(edge.Source, edge.Target, copyEdgeProperties(edge.Properties)); err != nil {
+			return nil, 0, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+
+		totalWeight += weights[index]
+	}
+
+	return tree, totalWeight, nil
+}
+
+type weightItem[K comparable, W Number] struct {
+	hash   K
+	weight W
+}
+
+// weightQueue is a min-heap of vertices, ordered by their weight and their hash. It implements
+// heap.Interface.
+type weightQueue[K comparable, W Number] []weightItem[K, W]
+
+func (q weightQueue[K, W]) Len() int {
+	return len(q)
+}
+
+func (q weightQueue[K, W]) Less(i, j int) bool {
+	if q[i].weight != q[j].weight {
+		return q[i].weight < q[j].weight
+	}
+
+	return keyLess(q[i].hash, q[j].hash)
+}
+
+func (q weightQueue[K, W]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *weightQueue[K, W]) Push(item any) {
+	*q = append(*q, item.(weightItem[K, W]))
+}
+
+func (q *weightQueue[K, W]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return item
+}
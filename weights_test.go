@@ -0,0 +1,307 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestShortestPathByWeight(t *testing.T) {
+	tests := map[string]struct {
+		isDirected     bool
+		vertices       []string
+		edges          []Edge[string]
+		source         string
+		target         string
+		expectedPath   []string
+		expectedWeight float64
+		shouldFail     bool
+	}{
+		"decimal costs": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Attributes: map[string]string{"cost": "0.5"}}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Attributes: map[string]string{"cost": "0.25"}}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Attributes: map[string]string{"cost": "0.3"}}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Attributes: map[string]string{"cost": "0.5"}}},
+			},
+			source:         "A",
+			target:         "D",
+			expectedPath:   []string{"A", "B", "D"},
+			expectedWeight: 0.75,
+		},
+		"fallback to stored weight": {
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Attributes: map[string]string{"cost": "1.5"}}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+			},
+			source:         "C",
+			target:         "A",
+			expectedPath:   []string{"C", "B", "A"},
+			expectedWeight: 2.5,
+		},
+		"source equal to target": {
+			vertices:       []string{"A"},
+			source:         "A",
+			target:         "A",
+			expectedPath:   []string{"A"},
+			expectedWeight: 0,
+		},
+		"negative weight": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Attributes: map[string]string{"cost": "-0.5"}}},
+			},
+			source:     "A",
+			target:     "B",
+			shouldFail: true,
+		},
+		"unknown target": {
+			vertices:   []string{"A"},
+			source:     "A",
+			target:     "B",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(StringHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			edgeOptions := []func(*EdgeProperties){EdgeWeight(edge.Properties.Weight)}
+			for key, value := range edge.Properties.Attributes {
+				edgeOptions = append(edgeOptions, EdgeAttribute(key, value))
+			}
+			if err := graph.AddEdge(edge.Source, edge.Target, edgeOptions...); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		path, weight, err := ShortestPathByWeight(graph, test.source, test.target, FloatAttributeWeight[string]("cost"))
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !orderedSlicesAreEqual(path, test.expectedPath) {
+			t.Errorf("%s: path expectancy doesn't match: expected %v, got %v", name, test.expectedPath, path)
+		}
+
+		if weight != test.expectedWeight {
+			t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, test.expectedWeight, weight)
+		}
+	}
+}
+
+func TestShortestPathByWeight_NotReachable(t *testing.T) {
+	graph := New(IntHash, Directed())
+	_ = graph.AddVertex(1)
+	_ = graph.AddVertex(2)
+	_ = graph.AddEdge(2, 1)
+
+	if _, _, err := ShortestPathByWeight(graph, 1, 2, IntWeight[int]()); !errors.Is(err, ErrTargetNotReachable) {
+		t.Errorf("error expectancy doesn't match: expected %v, got %v", ErrTargetNotReachable, err)
+	}
+}
+
+func TestShortestPathByWeight_IntWeight(t *testing.T) {
+	graph := contractionBenchmarkGraph()
+
+	expected, err := ShortestPath(graph, 0, 39*40+39)
+	if err != nil {
+		t.Fatalf("failed to compute shortest path: %s", err.Error())
+	}
+
+	expectedWeight := 0
+	for i := 1; i < len(expected); i++ {
+		edge, _ := graph.Edge(expected[i-1], expected[i])
+		expectedWeight += edge.Properties.Weight
+	}
+
+	_, weight, err := ShortestPathByWeight(graph, 0, 39*40+39, IntWeight[int]())
+	if err != nil {
+		t.Fatalf("failed to compute shortest path by weight: %s", err.Error())
+	}
+
+	if weight != expectedWeight {
+		t.Errorf("weight expectancy doesn't match: expected %v, got %v", expectedWeight, weight)
+	}
+}
+
+func TestShortestPathWithWeight(t *testing.T) {
+	latency := func(e Edge[string]) int {
+		value, _ := e.Properties.AttributeInt("latency")
+		return value
+	}
+
+	tests := map[string]struct {
+		vertices     []string
+		edges        []Edge[string]
+		source       string
+		target       string
+		weight       func(e Edge[string]) int
+		expectedPath []string
+		shouldFail   bool
+	}{
+		"route by latency instead of weight": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1, Attributes: map[string]string{"latency": "10"}}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 1, Attributes: map[string]string{"latency": "10"}}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 5, Attributes: map[string]string{"latency": "2"}}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 5, Attributes: map[string]string{"latency": "3"}}},
+			},
+			source:       "A",
+			target:       "D",
+			weight:       latency,
+			expectedPath: []string{"A", "C", "D"},
+		},
+		"route by stored weight": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1, Attributes: map[string]string{"latency": "10"}}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 1, Attributes: map[string]string{"latency": "10"}}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 5, Attributes: map[string]string{"latency": "2"}}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 5, Attributes: map[string]string{"latency": "3"}}},
+			},
+			source:       "A",
+			target:       "D",
+			weight:       IntWeight[string](),
+			expectedPath: []string{"A", "B", "D"},
+		},
+		"negative weight": {
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Attributes: map[string]string{"latency": "-1"}}},
+			},
+			source:     "A",
+			target:     "B",
+			weight:     latency,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		graph := New(StringHash, Directed())
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			edgeOptions := []func(*EdgeProperties){EdgeWeight(edge.Properties.Weight)}
+			for key, value := range edge.Properties.Attributes {
+				edgeOptions = append(edgeOptions, EdgeAttribute(key, value))
+			}
+			if err := graph.AddEdge(edge.Source, edge.Target, edgeOptions...); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		path, err := ShortestPathWithWeight(graph, test.source, test.target, test.weight)
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if !orderedSlicesAreEqual(path, test.expectedPath) {
+			t.Errorf("%s: path expectancy doesn't match: expected %v, got %v", name, test.expectedPath, path)
+		}
+	}
+}
+
+func TestMinimumSpanningTreeByWeight(t *testing.T) {
+	tests := map[string]struct {
+		isDirected     bool
+		vertices       []int
+		edges          []Edge[int]
+		expectedEdges  [][2]int
+		expectedWeight float64
+		shouldFail     bool
+	}{
+		"decimal costs": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Attributes: map[string]string{"cost": "0.5"}}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Attributes: map[string]string{"cost": "0.25"}}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Attributes: map[string]string{"cost": "0.3"}}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Attributes: map[string]string{"cost": "1.5"}}},
+			},
+			expectedEdges:  [][2]int{{1, 3}, {2, 3}, {3, 4}},
+			expectedWeight: 2.05,
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2},
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		var options []func(*Traits)
+		if test.isDirected {
+			options = append(options, Directed())
+		}
+
+		graph := New(IntHash, options...)
+
+		for _, vertex := range test.vertices {
+			_ = graph.AddVertex(vertex)
+		}
+
+		for _, edge := range test.edges {
+			edgeOptions := []func(*EdgeProperties){}
+			for key, value := range edge.Properties.Attributes {
+				edgeOptions = append(edgeOptions, EdgeAttribute(key, value))
+			}
+			if err := graph.AddEdge(edge.Source, edge.Target, edgeOptions...); err != nil {
+				t.Fatalf("%s: failed to add edge: %s", name, err.Error())
+			}
+		}
+
+		tree, weight, err := MinimumSpanningTreeByWeight(graph, FloatAttributeWeight[int]("cost"))
+
+		if test.shouldFail != (err != nil) {
+			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, err != nil, err)
+		}
+
+		if test.shouldFail {
+			continue
+		}
+
+		if tree.Size() != len(test.expectedEdges) {
+			t.Errorf("%s: size expectancy doesn't match: expected %v, got %v", name, len(test.expectedEdges), tree.Size())
+		}
+
+		for _, edge := range test.expectedEdges {
+			if !tree.HasEdge(edge[0], edge[1]) {
+				t.Errorf("%s: expected edge (%v, %v) doesn't exist", name, edge[0], edge[1])
+			}
+		}
+
+		if weight < test.expectedWeight-1e-9 || weight > test.expectedWeight+1e-9 {
+			t.Errorf("%s: weight expectancy doesn't match: expected %v, got %v", name, test.expectedWeight, weight)
+		}
+	}
+}